@@ -7,15 +7,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -153,13 +150,32 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}
 
+		// Replace account IDs, access keys, IP addresses and secrets with placeholders.
+		// Responses are restored before being returned to the provider and sanitized again before the cassette is saved.
+		sanitizer := vcr.NewSanitizer(vcr.SanitizationRules()...)
+
 		// Define how VCR will match requests to stored interactions.
+		// Live requests are sanitized so that they can be compared with the recorded requests.
 		matchFunc := func(r *http.Request, i cassette.Request) bool {
 			if r.Method != i.Method {
 				return false
 			}
 
-			if r.URL.String() != i.URL {
+			var b bytes.Buffer
+			if r.Body != nil {
+				if _, err := b.ReadFrom(r.Body); err != nil {
+					tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
+						"error": err,
+					})
+					return false
+				}
+
+				r.Body = io.NopCloser(&b)
+			}
+
+			requestURL, body := sanitizer.SanitizeRequest(r, b.String())
+
+			if requestURL != i.URL {
 				return false
 			}
 
@@ -167,65 +183,21 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 				return true
 			}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
 			// If body matches identically, we are done.
 			if body == i.Body {
 				return true
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson any
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
+			// Bodies might be semantically equal, e.g. JSON with reordered keys or a different idempotency token.
+			ok, err := vcr.RequestBodiesMatch(r, body, i.Body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request body", map[string]any{
+					"error": err,
+				})
+				return false
 			}
 
-			return false
+			return ok
 		}
 
 		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
@@ -233,6 +205,9 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.New(cassetteName,
 			recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+			recorder.WithHook(sanitizer.SanitizeInteraction, recorder.AfterCaptureHook),
+			recorder.WithHook(sanitizer.RestoreResponse, recorder.BeforeResponseReplayHook),
+			recorder.WithHook(sanitizer.SanitizeInteraction, recorder.BeforeSaveHook),
 			recorder.WithMatcher(matchFunc),
 			recorder.WithMode(vcrMode),
 			recorder.WithRealTransport(httpClient.Transport),
@@ -253,6 +228,14 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = new(conns.AWSClient)
 		}
 		meta.SetHTTPClient(ctx, httpClient)
+
+		// When replaying, skip time rather than waiting between polls of a resource's state.
+		// Each waiter gets its own clock so that concurrent waiters don't shorten each other's timeouts.
+		if vcrMode == recorder.ModeReplayOnly {
+			meta.SetClockFunc(ctx, func() vcr.Clock {
+				return vcr.NewReplayClock()
+			})
+		}
 		provider.SetMeta(meta)
 
 		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
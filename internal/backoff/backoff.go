@@ -6,8 +6,6 @@ package backoff
 import (
 	"context"
 	"time"
-
-	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // Inspired by https://github.com/ServiceWeaver/weaver and https://github.com/avast/retry-go.
@@ -17,6 +15,12 @@ type Timer interface {
 	After(time.Duration) <-chan time.Time
 }
 
+// Clock represents the clock used to track time and deadlines.
+type Clock interface {
+	Timer
+	Now() time.Time
+}
+
 // DelayFunc returns the duration to wait before the next attempt.
 type DelayFunc func(uint) time.Duration
 
@@ -96,8 +100,8 @@ func DefaultSDKv2HelperRetryCompatibleDelay() DelayFunc {
 // LoopConfig configures a loop.
 type LoopConfig struct {
 	delay       DelayFunc
+	clock       Clock
 	gracePeriod time.Duration
-	timer       Timer
 }
 
 // Option represents a loop option.
@@ -126,23 +130,48 @@ func WithDelay(d DelayFunc) Option {
 // for retries.
 func WithTimer(t Timer) Option {
 	return func(c *LoopConfig) {
-		c.timer = t
+		c.clock = &timerClock{Timer: t}
+	}
+}
+
+// WithClock provides a way to swap out the clock used for both waiting between attempts and tracking the timeout.
+// This is useful when replaying recorded interactions, where time can be skipped rather than waited for.
+func WithClock(clock Clock) Option {
+	if clock == nil {
+		return emptyOption
+	}
+
+	return func(c *LoopConfig) {
+		c.clock = clock
 	}
 }
 
-// Default timer is a wrapper around time.After
-type timerImpl struct{}
+// Default clock is a wrapper around time.After and time.Now
+type clockImpl struct{}
 
-func (t *timerImpl) After(d time.Duration) <-chan time.Time {
+func (c *clockImpl) After(d time.Duration) <-chan time.Time {
 	return time.After(d)
 }
 
+func (c *clockImpl) Now() time.Time {
+	return time.Now()
+}
+
+// timerClock is a Clock using a custom timer and the system time.
+type timerClock struct {
+	Timer
+}
+
+func (c *timerClock) Now() time.Time {
+	return time.Now()
+}
+
 // The default RetryConfig is backwards compatible with github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry.
 func defaultLoopConfig() LoopConfig {
 	return LoopConfig{
 		delay:       DefaultSDKv2HelperRetryCompatibleDelay(),
+		clock:       &clockImpl{},
 		gracePeriod: 30 * time.Second,
-		timer:       &timerImpl{},
 	}
 }
 
@@ -150,7 +179,7 @@ func defaultLoopConfig() LoopConfig {
 type Loop struct {
 	attempt     uint
 	config      LoopConfig
-	deadline    inttypes.Deadline
+	deadline    time.Time
 	gracePeriod time.Duration
 }
 
@@ -163,7 +192,7 @@ func NewLoopWithOptions(timeout time.Duration, opts ...Option) *Loop {
 
 	return &Loop{
 		config:      config,
-		deadline:    inttypes.NewDeadline(timeout),
+		deadline:    config.clock.Now().Add(timeout),
 		gracePeriod: config.gracePeriod,
 	}
 }
@@ -199,7 +228,11 @@ func (r *Loop) Reset() {
 
 // Remaining returns how long the duration has remaining.
 func (r *Loop) Remaining() time.Duration {
-	return r.deadline.Remaining()
+	if v := r.deadline.Sub(r.config.clock.Now()); v > 0 {
+		return v
+	}
+
+	return 0
 }
 
 // sleep sleeps for the specified duration or until the context is canceled, whichever occurs first.
@@ -211,6 +244,6 @@ func (r *Loop) sleep(ctx context.Context, d time.Duration) {
 	select {
 	case <-ctx.Done():
 		return
-	case <-r.config.timer.After(d):
+	case <-r.config.clock.After(d):
 	}
 }
@@ -112,3 +112,37 @@ func TestLoopWithTimeoutNoGracePeriod(t *testing.T) {
 		t.Errorf("Iterations = %v, want %v", got, want)
 	}
 }
+
+type skippingClock struct {
+	now time.Time
+}
+
+func (c *skippingClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func (c *skippingClock) Now() time.Time {
+	return c.now
+}
+
+func TestLoopWithClock(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	start := time.Now()
+	var n int
+	for l := NewLoopWithOptions(1*time.Minute, WithClock(&skippingClock{now: start}), WithDelay(FixedDelay(10*time.Second)), WithGracePeriod(0)); l.Continue(ctx); {
+		n++
+	}
+
+	if got, want := n, 7; got != want {
+		t.Errorf("Iterations = %v, want %v", got, want)
+	}
+
+	if elapsed := time.Since(start); elapsed > 1*time.Second {
+		t.Errorf("Elapsed = %v, want < 1s", elapsed)
+	}
+}
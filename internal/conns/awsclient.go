@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,6 +31,7 @@ type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clockFunc                 vcr.ClockFunc             // VCR testing clocks.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -201,6 +203,20 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// SetClockFunc sets the function that returns the clock each waiter uses to wait between polls of a resource's state when VCR testing.
+func (c *AWSClient) SetClockFunc(_ context.Context, f vcr.ClockFunc) {
+	c.clockFunc = f
+}
+
+// RegisterClock places any configured VCR testing ClockFunc into Context so it can be used by waiters.
+func (c *AWSClient) RegisterClock(ctx context.Context) context.Context {
+	if c.clockFunc == nil {
+		return ctx
+	}
+
+	return vcr.NewContextWithClockFunc(ctx, c.clockFunc)
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -235,6 +235,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
 	"github.com/aws/aws-sdk-go-v2/service/sso"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
 	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go-v2/service/swf"
@@ -1130,6 +1131,10 @@ func (c *AWSClient) SSOAdminClient(ctx context.Context) *ssoadmin.Client {
 	return errs.Must(client[*ssoadmin.Client](ctx, c, names.SSOAdmin, make(map[string]any)))
 }
 
+func (c *AWSClient) SSOOIDCClient(ctx context.Context) *ssooidc.Client {
+	return errs.Must(client[*ssooidc.Client](ctx, c, names.SSOOIDC, make(map[string]any)))
+}
+
 func (c *AWSClient) STSClient(ctx context.Context) *sts.Client {
 	return errs.Must(client[*sts.Client](ctx, c, names.STS, make(map[string]any)))
 }
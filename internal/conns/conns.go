@@ -20,6 +20,13 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithActions is an interface that extends ServicePackage with actions.
+// Actions are imperative operations that are invoked outside of the resource lifecycle.
+type ServicePackageWithActions interface {
+	ServicePackage
+	Actions(context.Context) []*types.ServicePackageAction
+}
+
 // ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
 // Ephemeral resources are resources that are not part of the Terraform state, but are used to create other resources.
 type ServicePackageWithEphemeralResources interface {
@@ -27,6 +34,19 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithFrameworkListResources is an interface that extends ServicePackage with Plugin Framework list resources.
+// List resources enumerate existing infrastructure for `terraform query`.
+type ServicePackageWithFrameworkListResources interface {
+	ServicePackage
+	FrameworkListResources(context.Context) []*types.ServicePackageFrameworkListResource
+}
+
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -27,6 +27,7 @@ const (
 	ErrActionExpandingResourceId    = "expanding resource id"
 	ErrActionFlatteningResourceId   = "flattening resource id"
 	ErrActionImporting              = "importing"
+	ErrActionInvoking               = "invoking"
 	ErrActionOpening                = "opening"
 	ErrActionReading                = "reading"
 	ErrActionRenewing               = "renewing"
@@ -96,6 +96,21 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to limit what resource sweepers delete
+const (
+	// If true, sweepers report what would be deleted without deleting anything
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated tag keys or key=value pairs. Matching resources are not deleted
+	SweepKeepTags = "TF_AWS_SWEEP_KEEP_TAGS"
+
+	// A duration such as 24h. Resources created more recently are not deleted
+	SweepKeepNewerThan = "TF_AWS_SWEEP_KEEP_NEWER_THAN"
+
+	// Comma-separated name prefixes. Resources whose names do not match are not deleted
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithIdentitySpec is implemented by list resources which populate list result identities.
+type ListResourceWithIdentitySpec interface {
+	SetIdentitySpec(identitySpec inttypes.Identity)
+}
+
+var _ ListResourceWithIdentitySpec = &ListResourceWithSDKv2Resource{}
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a ListResource that lists a resource implemented using the Terraform Plugin SDK.
+// The Plugin SDK resource's schema and identity schema are used for the list results.
+type ListResourceWithSDKv2Resource struct {
+	identitySpec   inttypes.Identity
+	resourceSchema *schema.Resource
+}
+
+// SetIdentitySpec sets the resource identity specification used to populate list result identities.
+func (l *ListResourceWithSDKv2Resource) SetIdentitySpec(identitySpec inttypes.Identity) {
+	l.identitySpec = identitySpec
+}
+
+// SetResourceSchema sets the Plugin SDK resource whose schemas are returned by RawV5Schemas.
+// This must be the resource as registered with the Plugin SDK provider, i.e. after any schema injection.
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(resource *schema.Resource) {
+	l.resourceSchema = resource
+}
+
+// RawV5Schemas returns the Plugin SDK resource's schema and identity schema.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resourceSchema.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = l.resourceSchema.ProtoIdentitySchema(ctx)()
+}
+
+// ResourceData returns a new, empty ResourceData for the Plugin SDK resource.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resourceSchema.Data(&terraform.InstanceState{})
+}
+
+// ReadResource populates ResourceData by invoking the Plugin SDK resource's Read handler.
+// The ResourceData's ID must already have been set.
+func (l *ListResourceWithSDKv2Resource) ReadResource(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := l.resourceSchema.SchemaMap()[names.AttrRegion]; ok {
+		if err := d.Set(names.AttrRegion, awsClient.Region(ctx)); err != nil {
+			diags.AddError("setting region", err.Error())
+			return diags
+		}
+	}
+
+	for _, v := range l.resourceSchema.ReadWithoutTimeout(ctx, d, awsClient) {
+		if v.Severity == sdkdiag.Error {
+			diags.AddError(v.Summary, v.Detail)
+		} else {
+			diags.AddWarning(v.Summary, v.Detail)
+		}
+	}
+
+	return diags
+}
+
+// SetResult sets the identity and, if requested, the resource object of a list result from ResourceData.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, awsClient *conns.AWSClient, includeResource bool, d *schema.ResourceData, result *list.ListResult) {
+	identity, err := d.Identity()
+	if err != nil {
+		result.Diagnostics.AddError("getting resource identity", err.Error())
+		return
+	}
+
+	for _, attr := range l.identitySpec.Attributes {
+		var v string
+
+		switch attr.Name {
+		case names.AttrAccountID:
+			v = awsClient.AccountID(ctx)
+		case names.AttrRegion:
+			v = awsClient.Region(ctx)
+		case names.AttrID:
+			v = d.Id()
+		default:
+			s, ok := d.Get(attr.Name).(string)
+			if !ok {
+				result.Diagnostics.AddError("setting resource identity", fmt.Sprintf("identity attribute %q is not a string", attr.Name))
+				return
+			}
+			v = s
+		}
+
+		if err := identity.Set(attr.Name, v); err != nil {
+			result.Diagnostics.AddError("setting resource identity", err.Error())
+			return
+		}
+	}
+
+	tfTypeIdentity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("converting resource identity", err.Error())
+		return
+	}
+	result.Identity.Raw = *tfTypeIdentity
+
+	if includeResource {
+		tfTypeResource, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("converting resource", err.Error())
+			return
+		}
+		result.Resource.Raw = *tfTypeResource
+	}
+}
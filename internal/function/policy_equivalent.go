@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = policyEquivalentFunction{}
+
+func NewPolicyEquivalentFunction() function.Function {
+	return &policyEquivalentFunction{}
+}
+
+type policyEquivalentFunction struct{}
+
+func (f policyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_equivalent"
+}
+
+func (f policyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_equivalent Function",
+		MarkdownDescription: "Checks whether two IAM policy documents are semantically equivalent, ignoring " +
+			"formatting, element order and the difference between single values and single-element lists.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document1",
+				MarkdownDescription: "IAM policy document",
+			},
+			function.StringParameter{
+				Name:                "document2",
+				MarkdownDescription: "IAM policy document to compare with",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f policyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg1, arg2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg1, &arg2))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, v := range []string{arg1, arg2} {
+		if !json.Valid([]byte(v)) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), "invalid JSON"))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(arg1, arg2)))
+}
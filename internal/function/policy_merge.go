@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents. Statements from source documents are combined and " +
+			"must have unique Sids. Statements from override documents replace any statement with the same Sid.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "source_documents",
+				MarkdownDescription: "IAM policy documents to combine, in order. Statement Sids must be unique across all source documents.",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "override_documents",
+				MarkdownDescription: "IAM policy documents to merge in, in order, after the source documents. A statement replaces any previous statement with the same Sid.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sources, overrides []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &sources, &overrides))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(sources, overrides)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergePolicies merges IAM policy documents using the same semantics as the
+// aws_iam_policy_document data source's source_policy_documents and
+// override_policy_documents arguments
+func mergePolicies(sources, overrides []string) (string, error) {
+	mergedDoc := &tfpolicy.Document{}
+
+	for i, v := range sources {
+		doc := &tfpolicy.Document{}
+		if err := json.Unmarshal([]byte(v), doc); err != nil {
+			return "", fmt.Errorf("source document %d: %w", i, err)
+		}
+
+		if err := mergedDoc.MergeSource(doc); err != nil {
+			return "", fmt.Errorf("source document %d: %w", i, err)
+		}
+	}
+
+	for i, v := range overrides {
+		doc := &tfpolicy.Document{}
+		if err := json.Unmarshal([]byte(v), doc); err != nil {
+			return "", fmt.Errorf("override document %d: %w", i, err)
+		}
+
+		mergedDoc.Merge(doc)
+	}
+
+	b, err := json.Marshal(mergedDoc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
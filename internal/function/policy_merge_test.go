@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+	expected := `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyMergeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_duplicateSourceSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyMergeFunctionConfig_duplicateSourceSid(),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid[\s\n]*\(A\)`),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_invalidJSON(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyMergeFunctionConfig_invalidJSON(),
+				ExpectError: regexache.MustCompile(`override[\s\n]*document[\s\n]*0`),
+			},
+		},
+	})
+}
+
+func testPolicyMergeFunctionConfig_basic() string {
+	return `
+locals {
+  source1 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      { Sid = "A", Effect = "Allow", Action = "s3:GetObject", Resource = "*" },
+      { Effect = "Allow", Action = "s3:ListBucket", Resource = "*" },
+    ]
+  })
+  source2 = jsonencode({
+    Statement = [
+      { Sid = "B", Effect = "Allow", Action = "s3:PutObject", Resource = "*" },
+    ]
+  })
+  override = jsonencode({
+    Statement = [
+      { Sid = "A", Effect = "Deny", Action = "s3:GetObject", Resource = "*" },
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::policy_merge([local.source1, local.source2], [local.override])
+}
+`
+}
+
+func testPolicyMergeFunctionConfig_duplicateSourceSid() string {
+	return `
+locals {
+  source = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      { Sid = "A", Effect = "Allow", Action = "s3:GetObject", Resource = "*" },
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::policy_merge([local.source, local.source], [])
+}
+`
+}
+
+func testPolicyMergeFunctionConfig_invalidJSON() string {
+	return `
+output "test" {
+  value = provider::aws::policy_merge([], ["invalid"])
+}
+`
+}
@@ -217,7 +217,7 @@ func main() {
 			generateTestConfig(g, testDirPath, "basic", tfTemplates, common)
 
 			// if resource.HasV6_0SDKv2Fix {
-			if !resource.MutableIdentity {
+			if !resource.MutableIdentity && !resource.HasNoPreExistingResource {
 				if resource.PreIdentityVersion.Equal(v5_100_0) {
 					tfTemplatesV5, err := tfTemplates.Clone()
 					if err != nil {
@@ -445,6 +445,7 @@ type ResourceDatum struct {
 	HasV6_0RefreshError         bool
 	RequiredEnvVars             []string
 	PreIdentityVersion          *version.Version
+	HasNoPreExistingResource    bool
 }
 
 func (d ResourceDatum) AdditionalTfVars() map[string]string {
@@ -662,6 +663,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "SDKDataSource":
 				break
 
+			case "FrameworkListResource", "SDKListResource":
+				// List Resources are tested along with the corresponding resource.
+				v.functionName = ""
+				return
+
 			case "SDKResource":
 				d.Implementation = implementationSDK
 				args := common.ParseArgs(m[3])
@@ -1049,6 +1055,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					}
 					d.PreIdentityVersion = version
 				}
+				if attr, ok := args.Keyword["hasNoPreExistingResource"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid hasNoPreExistingResource value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.HasNoPreExistingResource = b
+					}
+				}
 				if attr, ok := args.Keyword["tlsKey"]; ok {
 					if b, err := strconv.ParseBool(attr); err != nil {
 						v.errs = append(v.errs, fmt.Errorf("invalid tlsKey value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+const (
+	policyModelMarshallJSONStartSliceSize = 2
+)
+
+// Document is an IAM policy document.
+type Document struct {
+	Version    string       `json:",omitempty"`
+	Id         string       `json:",omitempty"`
+	Statements []*Statement `json:"Statement,omitempty"`
+}
+
+type Statement struct {
+	Sid           string                `json:",omitempty"`
+	Effect        string                `json:",omitempty"`
+	Actions       any                   `json:"Action,omitempty"`
+	NotActions    any                   `json:"NotAction,omitempty"`
+	Resources     any                   `json:"Resource,omitempty"`
+	NotResources  any                   `json:"NotResource,omitempty"`
+	Principals    StatementPrincipalSet `json:"Principal,omitempty"`
+	NotPrincipals StatementPrincipalSet `json:"NotPrincipal,omitempty"`
+	Conditions    StatementConditionSet `json:"Condition,omitempty"`
+}
+
+type StatementPrincipal struct {
+	Type        string
+	Identifiers any
+}
+
+type StatementCondition struct {
+	Test     string
+	Variable string
+	Values   any
+}
+
+type StatementPrincipalSet []StatementPrincipal
+type StatementConditionSet []StatementCondition
+
+// Merge merges in newDoc, overwriting any existing statements with the same Sid.
+func (s *Document) Merge(newDoc *Document) {
+	// adopt newDoc's Id
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	var seen bool
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+		seen = false
+		for i, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				s.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			s.Statements = append(s.Statements, newStatement)
+		}
+	}
+}
+
+// MergeSource merges in sourceDoc's statements like Merge,
+// but returns an error instead of overwriting if a statement Sid is already present.
+func (s *Document) MergeSource(sourceDoc *Document) error {
+	sidMap := make(map[string]struct{})
+	for _, stmt := range s.Statements {
+		if stmt.Sid != "" {
+			sidMap[stmt.Sid] = struct{}{}
+		}
+	}
+
+	// assure all statements in sourceDoc are unique before merging
+	for stmtIndex, stmt := range sourceDoc.Statements {
+		if stmt.Sid != "" {
+			if _, sidExists := sidMap[stmt.Sid]; sidExists {
+				return fmt.Errorf("duplicate Sid (%s) in statement %d", stmt.Sid, stmtIndex)
+			}
+			sidMap[stmt.Sid] = struct{}{}
+		}
+	}
+
+	s.Merge(sourceDoc)
+
+	return nil
+}
+
+func (ps StatementPrincipalSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]any{}
+
+	// Although IAM documentation says that "*" and {"AWS": "*"} are equivalent
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html),
+	// in practice they are not for IAM roles. IAM will return an error if trust
+	// policy have "*" or {"*": "*"} as principal, but will accept {"AWS": "*"}.
+	// Only {"*": "*"} should be normalized to "*".
+	if len(ps) == 1 {
+		p := ps[0]
+		if p.Type == "*" {
+			if sv, ok := p.Identifiers.(string); ok && sv == "*" {
+				return []byte(`"*"`), nil
+			}
+
+			if av, ok := p.Identifiers.([]string); ok && len(av) == 1 && av[0] == "*" {
+				return []byte(`"*"`), nil
+			}
+		}
+	}
+
+	for _, p := range ps {
+		switch i := p.Identifiers.(type) {
+		case []string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = make([]string, 0, len(i))
+			case string:
+				// Convert to []string to prevent panic
+				raw[p.Type] = make([]string, 0, len(i)+1)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+			}
+			slices.Sort(i)
+			slices.Reverse(i)
+			raw[p.Type] = append(raw[p.Type].([]string), i...)
+		case string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = i
+			case string:
+				// Convert to []string to stop drop of principals
+				raw[p.Type] = make([]string, 0, policyModelMarshallJSONStartSliceSize)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			case []string:
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			}
+		default:
+			return []byte{}, fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (ps *StatementPrincipalSet) UnmarshalJSON(b []byte) error {
+	var out StatementPrincipalSet
+
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		out = append(out, StatementPrincipal{Type: "*", Identifiers: []string{"*"}})
+	case map[string]any:
+		for key, value := range data.(map[string]any) {
+			switch vt := value.(type) {
+			case string:
+				out = append(out, StatementPrincipal{Type: key, Identifiers: value.(string)})
+			case []any:
+				values := []string{}
+				for _, v := range value.([]any) {
+					values = append(values, v.(string))
+				}
+				slices.Sort(values)
+				out = append(out, StatementPrincipal{Type: key, Identifiers: values})
+			default:
+				return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", vt)
+			}
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
+func (cs StatementConditionSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]map[string]any{}
+
+	for _, c := range cs {
+		if _, ok := raw[c.Test]; !ok {
+			raw[c.Test] = map[string]any{}
+		}
+		if _, ok := raw[c.Test][c.Variable]; !ok {
+			raw[c.Test][c.Variable] = []string{}
+		}
+		switch i := c.Values.(type) {
+		case []string:
+			// order matters with values so not sorting here
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i...)
+		case string:
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i)
+		default:
+			return nil, fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet: %s", i)
+		}
+	}
+
+	// flatten entries with a single item to match AWS IAM syntax
+	for k1 := range raw {
+		for k2 := range raw[k1] {
+			items := raw[k1][k2].([]string)
+			if len(items) == 1 {
+				raw[k1][k2] = items[0]
+			}
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (cs *StatementConditionSet) UnmarshalJSON(b []byte) error {
+	var out StatementConditionSet
+
+	var data map[string]map[string]any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for test_key, test_value := range data {
+		for var_key, var_values := range test_value {
+			switch var_values := var_values.(type) {
+			case string:
+				out = append(out, StatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
+			case bool:
+				out = append(out, StatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case []any:
+				values := []string{}
+				for _, v := range var_values {
+					values = append(values, v.(string))
+				}
+				out = append(out, StatementCondition{Test: test_key, Variable: var_key, Values: values})
+			}
+		}
+	}
+
+	*cs = out
+	return nil
+}
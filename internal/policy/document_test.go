@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDocumentMerge(t *testing.T) {
+	t.Parallel()
+
+	doc := &Document{
+		Version: "2008-10-17",
+		Statements: []*Statement{
+			{Sid: "A", Effect: "Allow", Actions: "s3:GetObject"},
+			{Effect: "Allow", Actions: "s3:ListBucket"},
+		},
+	}
+	doc.Merge(&Document{
+		Version: "2012-10-17",
+		Statements: []*Statement{
+			{Sid: "A", Effect: "Deny", Actions: "s3:GetObject"},
+			{Sid: "B", Effect: "Allow", Actions: "s3:PutObject"},
+		},
+	})
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := string(b), `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject"},{"Effect":"Allow","Action":"s3:ListBucket"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject"}]}`; got != want {
+		t.Errorf("Merge() = %s, want %s", got, want)
+	}
+}
+
+func TestDocumentMergeSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		source  *Document
+		wantErr bool
+	}{
+		"unique Sids": {
+			source: &Document{
+				Statements: []*Statement{
+					{Sid: "B", Effect: "Allow", Actions: "s3:PutObject"},
+					{Effect: "Allow", Actions: "s3:GetObject"},
+				},
+			},
+		},
+		"duplicate Sid": {
+			source: &Document{
+				Statements: []*Statement{
+					{Sid: "A", Effect: "Deny", Actions: "s3:GetObject"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := &Document{
+				Statements: []*Statement{
+					{Sid: "A", Effect: "Allow", Actions: "s3:GetObject"},
+				},
+			}
+			err := doc.MergeSource(testCase.source)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("MergeSource() err = %v, wantErr %t", err, want)
+			}
+
+			if err != nil {
+				if got, want := len(doc.Statements), 1; got != want {
+					t.Errorf("MergeSource() statements = %d, want %d", got, want)
+				}
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -25,6 +27,40 @@ type interceptorFunc[Request, Response any] func(context.Context, interceptorOpt
 
 type interceptorInvocations []any
 
+// An action interceptor is functionality invoked during the action's Invoke request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+// In other cases all interceptors in the chain are run.
+type actionInvokeInterceptor interface {
+	// invoke is invoked for an Invoke call.
+	invoke(context.Context, interceptorOptions[action.InvokeRequest, action.InvokeResponse]) diag.Diagnostics
+}
+
+// actionInvoke returns a slice of interceptors that run on action Invoke.
+func (s interceptorInvocations) actionInvoke() []interceptorFunc[action.InvokeRequest, action.InvokeResponse] {
+	return tfslices.ApplyToAll(tfslices.Filter(s, func(e any) bool {
+		_, ok := e.(actionInvokeInterceptor)
+		return ok
+	}), func(e any) interceptorFunc[action.InvokeRequest, action.InvokeResponse] {
+		return e.(actionInvokeInterceptor).invoke
+	})
+}
+
+type actionSchemaInterceptor interface {
+	// schema is invoked for a Schema call.
+	schema(context.Context, interceptorOptions[action.SchemaRequest, action.SchemaResponse]) diag.Diagnostics
+}
+
+// actionSchema returns a slice of interceptors that run on action Schema.
+func (s interceptorInvocations) actionSchema() []interceptorFunc[action.SchemaRequest, action.SchemaResponse] {
+	return tfslices.ApplyToAll(tfslices.Filter(s, func(e any) bool {
+		_, ok := e.(actionSchemaInterceptor)
+		return ok
+	}), func(e any) interceptorFunc[action.SchemaRequest, action.SchemaResponse] {
+		return e.(actionSchemaInterceptor).schema
+	})
+}
+
 // A data source interceptor is functionality invoked during the data source's CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
@@ -135,6 +171,39 @@ func (s interceptorInvocations) ephemeralResourceSchema() []interceptorFunc[ephe
 	})
 }
 
+// A list resource interceptor is functionality invoked during the list resource's List request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+type listResourceListInterceptor interface {
+	// list is invoked for a List call.
+	list(context.Context, interceptorOptions[list.ListRequest, list.ListResultsStream]) diag.Diagnostics
+}
+
+// listResourceList returns a slice of interceptors that run on list resource List.
+func (s interceptorInvocations) listResourceList() []interceptorFunc[list.ListRequest, list.ListResultsStream] {
+	return tfslices.ApplyToAll(tfslices.Filter(s, func(e any) bool {
+		_, ok := e.(listResourceListInterceptor)
+		return ok
+	}), func(e any) interceptorFunc[list.ListRequest, list.ListResultsStream] {
+		return e.(listResourceListInterceptor).list
+	})
+}
+
+type listResourceSchemaInterceptor interface {
+	// schema is invoked for a ListResourceConfigSchema call.
+	schema(context.Context, interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) diag.Diagnostics
+}
+
+// listResourceSchema returns a slice of interceptors that run on list resource ListResourceConfigSchema.
+func (s interceptorInvocations) listResourceSchema() []interceptorFunc[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse] {
+	return tfslices.ApplyToAll(tfslices.Filter(s, func(e any) bool {
+		_, ok := e.(listResourceSchemaInterceptor)
+		return ok
+	}), func(e any) interceptorFunc[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse] {
+		return e.(listResourceSchemaInterceptor).schema
+	})
+}
+
 // A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
@@ -276,12 +345,16 @@ const (
 
 // interceptedRequest represents a Plugin Framework request type that can be intercepted.
 type interceptedRequest interface {
-	datasource.SchemaRequest |
+	action.SchemaRequest |
+		action.InvokeRequest |
+		datasource.SchemaRequest |
 		datasource.ReadRequest |
 		ephemeral.SchemaRequest |
 		ephemeral.OpenRequest |
 		ephemeral.RenewRequest |
 		ephemeral.CloseRequest |
+		list.ListResourceSchemaRequest |
+		list.ListRequest |
 		resource.SchemaRequest |
 		resource.CreateRequest |
 		resource.ReadRequest |
@@ -293,12 +366,16 @@ type interceptedRequest interface {
 
 // interceptedResponse represents a Plugin Framework response type that can be intercepted.
 type interceptedResponse interface {
-	datasource.SchemaResponse |
+	action.SchemaResponse |
+		action.InvokeResponse |
+		datasource.SchemaResponse |
 		datasource.ReadResponse |
 		ephemeral.SchemaResponse |
 		ephemeral.OpenResponse |
 		ephemeral.RenewResponse |
 		ephemeral.CloseResponse |
+		list.ListResourceSchemaResponse |
+		list.ListResultsStream |
 		resource.SchemaResponse |
 		resource.CreateResponse |
 		resource.ReadResponse |
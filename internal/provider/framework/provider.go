@@ -10,18 +10,22 @@ import (
 	"iter"
 	"log"
 	"slices"
+	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -40,13 +44,17 @@ var (
 
 var (
 	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithActions            = &frameworkProvider{}
 	_ provider.ProviderWithFunctions          = &frameworkProvider{}
 	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 type frameworkProvider struct {
+	actions            []func() action.Action
 	dataSources        []func() datasource.DataSource
 	ephemeralResources []func() ephemeral.EphemeralResource
+	listResources      []func() list.ListResource
 	primary            interface{ Meta() any }
 	resources          []func() resource.Resource
 	servicePackages    iter.Seq[conns.ServicePackage]
@@ -58,8 +66,10 @@ func NewProvider(ctx context.Context, primary interface{ Meta() any }) (provider
 	log.Printf("Creating Terraform AWS Provider (Framework-style)...")
 
 	provider := &frameworkProvider{
+		actions:            make([]func() action.Action, 0),
 		dataSources:        make([]func() datasource.DataSource, 0),
 		ephemeralResources: make([]func() ephemeral.EphemeralResource, 0),
+		listResources:      make([]func() list.ListResource, 0),
 		primary:            primary,
 		resources:          make([]func() resource.Resource, 0),
 		servicePackages:    primary.Meta().(*conns.AWSClient).ServicePackages(ctx),
@@ -352,6 +362,8 @@ func (p *frameworkProvider) Configure(ctx context.Context, request provider.Conf
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
+	response.ActionData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -378,6 +390,22 @@ func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() eph
 	return slices.Clone(p.ephemeralResources)
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// All list resources must have unique type names.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return slices.Clone(p.listResources)
+}
+
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// All actions must have unique type names.
+func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
+	return slices.Clone(p.actions)
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -387,6 +415,9 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewPolicyEquivalentFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -450,6 +481,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
+						ctx = c.RegisterClock(ctx)
 						ctx = fwflex.RegisterLogger(ctx)
 					}
 
@@ -509,6 +541,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
+							ctx = c.RegisterClock(ctx)
 							ctx = fwflex.RegisterLogger(ctx)
 							ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
 						}
@@ -523,6 +556,65 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 			}
 		}
 
+		if v, ok := sp.(conns.ServicePackageWithActions); ok {
+			for _, v := range v.Actions(ctx) {
+				typeName := v.TypeName
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("creating action (%s): %w", typeName, err))
+					continue
+				}
+
+				var isRegionOverrideEnabled bool
+				if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+					isRegionOverrideEnabled = true
+				}
+
+				var interceptors interceptorInvocations
+
+				if isRegionOverrideEnabled {
+					v := v.Region.Value()
+
+					interceptors = append(interceptors, actionInjectRegionAttribute())
+					if v.IsValidateOverrideInPartition {
+						interceptors = append(interceptors, actionValidateRegion())
+					}
+				}
+
+				opts := wrappedActionOptions{
+					// bootstrapContext is run on all wrapped methods before any interceptors.
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+						var overrideRegion string
+
+						if isRegionOverrideEnabled && getAttribute != nil {
+							var target types.String
+							diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+
+							overrideRegion = target.ValueString()
+						}
+
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+						if c != nil {
+							ctx = c.RegisterLogger(ctx)
+							ctx = c.RegisterClock(ctx)
+							ctx = fwflex.RegisterLogger(ctx)
+						}
+						return ctx, diags
+					},
+					interceptors: interceptors,
+					typeName:     v.TypeName,
+				}
+				p.actions = append(p.actions, func() action.Action {
+					return newWrappedAction(inner, opts)
+				})
+			}
+		}
+
 		for _, res := range sp.FrameworkResources(ctx) {
 			typeName := res.TypeName
 			inner, err := res.Factory(ctx)
@@ -597,6 +689,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
+						ctx = c.RegisterClock(ctx)
 						ctx = fwflex.RegisterLogger(ctx)
 					}
 
@@ -614,11 +707,104 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				return newWrappedResource(inner, opts)
 			})
 		}
+
+		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			for _, v := range v.FrameworkListResources(ctx) {
+				typeName := v.TypeName
+				inner := v.Factory()
+
+				if inner, ok := inner.(framework.ListResourceWithIdentitySpec); ok {
+					inner.SetIdentitySpec(v.Identity)
+				}
+
+				opts := p.wrappedListResourceOptions(servicePackageName, v.Name, typeName, v.Region)
+				p.listResources = append(p.listResources, func() list.ListResource {
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
+
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			for _, v := range v.SDKListResources(ctx) {
+				typeName := v.TypeName
+				inner := v.Factory()
+
+				// The list resource's schemas must match the Plugin SDK resource's exactly,
+				// so use the resource as registered (and wrapped) by the primary provider.
+				var sdkResource *sdkschema.Resource
+				if primary, ok := p.primary.(*sdkschema.Provider); ok {
+					sdkResource = primary.ResourcesMap[typeName]
+				}
+				if sdkResource == nil {
+					errs = append(errs, fmt.Errorf("list resource type %s: no corresponding Plugin SDK resource", typeName))
+					continue
+				}
+				inner.SetResourceSchema(sdkResource)
+
+				if inner, ok := inner.(framework.ListResourceWithIdentitySpec); ok {
+					inner.SetIdentitySpec(v.Identity)
+				}
+
+				opts := p.wrappedListResourceOptions(servicePackageName, v.Name, typeName, v.Region)
+				p.listResources = append(p.listResources, func() list.ListResource {
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
 	}
 
 	return errors.Join(errs...)
 }
 
+// wrappedListResourceOptions returns the options used to wrap a list resource.
+func (p *frameworkProvider) wrappedListResourceOptions(servicePackageName, name, typeName string, region unique.Handle[inttypes.ServicePackageResourceRegion]) wrappedListResourceOptions {
+	var isRegionOverrideEnabled bool
+	if v := region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
+
+	var interceptors interceptorInvocations
+
+	if isRegionOverrideEnabled {
+		v := region.Value()
+
+		interceptors = append(interceptors, listResourceInjectRegionAttribute())
+		if v.IsValidateOverrideInPartition {
+			interceptors = append(interceptors, listResourceValidateRegion())
+		}
+	}
+
+	return wrappedListResourceOptions{
+		// bootstrapContext is run on all wrapped methods before any interceptors.
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var overrideRegion string
+
+			if isRegionOverrideEnabled && getAttribute != nil {
+				var target types.String
+				diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+				if diags.HasError() {
+					return ctx, diags
+				}
+
+				overrideRegion = target.ValueString()
+			}
+
+			ctx = conns.NewResourceContext(ctx, servicePackageName, name, overrideRegion)
+			if c != nil {
+				ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+				ctx = c.RegisterLogger(ctx)
+				ctx = c.RegisterClock(ctx)
+				ctx = fwflex.RegisterLogger(ctx)
+			}
+
+			return ctx, diags
+		},
+		interceptors: interceptors,
+		typeName:     typeName,
+	}
+}
+
 // validateResourceSchemas is called from `New` to validate Terraform Plugin Framework-style resource schemas.
 func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 	var errs []error
@@ -658,6 +844,28 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 			}
 		}
 
+		if v, ok := sp.(conns.ServicePackageWithActions); ok {
+			for _, v := range v.Actions(ctx) {
+				typeName := v.TypeName
+				a, err := v.Factory(ctx)
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("creating action (%s): %w", typeName, err))
+					continue
+				}
+
+				schemaResponse := action.SchemaResponse{}
+				a.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+
+				if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s action", names.AttrRegion, typeName))
+						continue
+					}
+				}
+			}
+		}
+
 		if v, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
 			for _, v := range v.EphemeralResources(ctx) {
 				typeName := v.TypeName
@@ -680,6 +888,22 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 			}
 		}
 
+		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			for _, v := range v.FrameworkListResources(ctx) {
+				if err := validateListResourceSchema(ctx, v.Factory(), v.TypeName, v.Region); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			for _, v := range v.SDKListResources(ctx) {
+				if err := validateListResourceSchema(ctx, v.Factory(), v.TypeName, v.Region); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+
 		for _, v := range sp.FrameworkResources(ctx) {
 			typeName := v.TypeName
 			r, err := v.Factory(ctx)
@@ -726,3 +950,16 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 
 	return errors.Join(errs...)
 }
+
+func validateListResourceSchema(ctx context.Context, lr list.ListResource, typeName string, region unique.Handle[inttypes.ServicePackageResourceRegion]) error {
+	schemaResponse := list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+	if v := region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+			return fmt.Errorf("`%s` attribute is defined: %s list resource", names.AttrRegion, typeName)
+		}
+	}
+
+	return nil
+}
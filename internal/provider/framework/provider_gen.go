@@ -1857,6 +1857,13 @@ func endpointsBlock() schema.SetNestedBlock {
 					Description: "Use this to override the default service endpoint URL",
 				},
 
+				// ssooidc
+
+				"ssooidc": schema.StringAttribute{
+					Optional:    true,
+					Description: "Use this to override the default service endpoint URL",
+				},
+
 				// storagegateway
 
 				"storagegateway": schema.StringAttribute{
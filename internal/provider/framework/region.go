@@ -7,11 +7,15 @@ import (
 	"context"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -109,6 +113,56 @@ func dataSourceSetRegionInState() dataSourceCRUDInterceptor {
 	return &dataSourceSetRegionInStateInterceptor{}
 }
 
+type actionInjectRegionAttributeInterceptor struct{}
+
+func (a actionInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[action.SchemaRequest, action.SchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]aschema.Attribute)
+		}
+		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
+			// Inject a top-level "region" attribute.
+			response.Schema.Attributes[names.AttrRegion] = aschema.StringAttribute{
+				Optional:    true,
+				Description: names.TopLevelRegionAttributeDescription,
+			}
+		}
+	}
+
+	return diags
+}
+
+// actionInjectRegionAttribute injects a top-level "region" attribute into an action's schema.
+func actionInjectRegionAttribute() actionSchemaInterceptor {
+	return &actionInjectRegionAttributeInterceptor{}
+}
+
+type actionValidateRegionInterceptor struct{}
+
+func (a actionValidateRegionInterceptor) invoke(ctx context.Context, opts interceptorOptions[action.InvokeRequest, action.InvokeResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		// Validate the per-resource Region override value before the action runs.
+		diags.Append(validateInContextRegionInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// actionValidateRegion validates that the value of the top-level `region` attribute is in the configured AWS partition.
+func actionValidateRegion() actionInvokeInterceptor {
+	return &actionValidateRegionInterceptor{}
+}
+
 type ephemeralResourceInjectRegionAttributeInterceptor struct{}
 
 func (r ephemeralResourceInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[ephemeral.SchemaRequest, ephemeral.SchemaResponse]) diag.Diagnostics {
@@ -184,6 +238,56 @@ func ephemeralResourceValidateRegion() ephemeralResourceORCInterceptor {
 	return &ephemeralResourceValidateRegionInterceptor{}
 }
 
+type listResourceInjectRegionAttributeInterceptor struct{}
+
+func (r listResourceInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]listschema.Attribute)
+		}
+		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
+			// Inject a top-level "region" attribute.
+			response.Schema.Attributes[names.AttrRegion] = listschema.StringAttribute{
+				Optional:    true,
+				Description: names.TopLevelRegionAttributeDescription,
+			}
+		}
+	}
+
+	return diags
+}
+
+// listResourceInjectRegionAttribute injects a top-level "region" attribute into a list resource's configuration schema.
+func listResourceInjectRegionAttribute() listResourceSchemaInterceptor {
+	return &listResourceInjectRegionAttributeInterceptor{}
+}
+
+type listResourceValidateRegionInterceptor struct{}
+
+func (r listResourceValidateRegionInterceptor) list(ctx context.Context, opts interceptorOptions[list.ListRequest, list.ListResultsStream]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		// As list resources have no ModifyPlan functionality we validate the per-resource Region override value here.
+		diags.Append(validateInContextRegionInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// listResourceValidateRegion validates that the value of the top-level `region` attribute is in the configured AWS partition.
+func listResourceValidateRegion() listResourceListInterceptor {
+	return &listResourceValidateRegionInterceptor{}
+}
+
 type resourceInjectRegionAttributeInterceptor struct{}
 
 func (r resourceInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) diag.Diagnostics {
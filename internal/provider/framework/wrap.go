@@ -6,9 +6,11 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -138,6 +140,127 @@ func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasour
 	}
 }
 
+type wrappedActionOptions struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	interceptors     interceptorInvocations
+	typeName         string
+}
+
+// wrappedAction represents an interceptor dispatcher for a Plugin Framework action.
+type wrappedAction struct {
+	inner action.ActionWithConfigure
+	meta  *conns.AWSClient
+	opts  wrappedActionOptions
+}
+
+func newWrappedAction(inner action.ActionWithConfigure, opts wrappedActionOptions) action.ActionWithConfigure {
+	return &wrappedAction{
+		inner: inner,
+		opts:  opts,
+	}
+}
+
+func (w *wrappedAction) Metadata(ctx context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	// This method does not call down to the inner action.
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	f := func(ctx context.Context, request *action.SchemaRequest, response *action.SchemaResponse) diag.Diagnostics {
+		w.inner.Schema(ctx, *request, response)
+		return response.Diagnostics
+	}
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.actionSchema(), f, w.meta)(ctx, &request, response)...)
+
+	// Validate the action's model against the schema.
+	if v, ok := w.inner.(framework.ActionValidateModel); ok {
+		response.Diagnostics.Append(v.ValidateModel(ctx, &response.Schema)...)
+		if response.Diagnostics.HasError() {
+			response.Diagnostics.AddError("action model validation error", w.opts.typeName)
+			return
+		}
+	} else {
+		response.Diagnostics.AddError("missing framework.ActionValidateModel", w.opts.typeName)
+	}
+}
+
+func (w *wrappedAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	f := func(ctx context.Context, request *action.InvokeRequest, response *action.InvokeResponse) diag.Diagnostics {
+		w.inner.Invoke(ctx, *request, response)
+		return response.Diagnostics
+	}
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.actionInvoke(), f, w.meta)(ctx, &request, response)...)
+}
+
+func (w *wrappedAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	if v, ok := w.inner.(action.ActionWithConfigValidators); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		if diags.HasError() {
+			tflog.Warn(ctx, "wrapping ConfigValidators", map[string]any{
+				"action":                 w.opts.typeName,
+				"bootstrapContext error": fwdiag.DiagnosticsString(diags),
+			})
+
+			return nil
+		}
+
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedAction) ValidateConfig(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
+	if v, ok := w.inner.(action.ActionWithValidateConfig); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+func (w *wrappedAction) ModifyPlan(ctx context.Context, request action.ModifyPlanRequest, response *action.ModifyPlanResponse) {
+	if v, ok := w.inner.(action.ActionWithModifyPlan); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ModifyPlan(ctx, request, response)
+	}
+}
+
 type wrappedEphemeralResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
@@ -279,6 +402,123 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 	}
 }
 
+type wrappedListResourceOptions struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	interceptors     interceptorInvocations
+	typeName         string
+}
+
+// wrappedListResource represents an interceptor dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	inner list.ListResourceWithConfigure
+	meta  *conns.AWSClient
+	opts  wrappedListResourceOptions
+}
+
+func newWrappedListResource(inner list.ListResourceWithConfigure, opts wrappedListResourceOptions) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		inner: inner,
+		opts:  opts,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method does not call down to the inner list resource.
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	f := func(ctx context.Context, request *list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) diag.Diagnostics {
+		w.inner.ListResourceConfigSchema(ctx, *request, response)
+		return response.Diagnostics
+	}
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.listResourceSchema(), f, w.meta)(ctx, &request, response)...)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	f := func(ctx context.Context, request *list.ListRequest, stream *list.ListResultsStream) diag.Diagnostics {
+		w.inner.List(ctx, *request, stream)
+		return nil
+	}
+	diags.Append(interceptedHandler(w.opts.interceptors.listResourceList(), f, w.meta)(ctx, &request, stream)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	}
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		if diags.HasError() {
+			tflog.Warn(ctx, "wrapping RawV5Schemas", map[string]any{
+				"list resource":          w.opts.typeName,
+				"bootstrapContext error": fwdiag.DiagnosticsString(diags),
+			})
+
+			return
+		}
+
+		v.RawV5Schemas(ctx, request, response)
+	}
+}
+
+func (w *wrappedListResource) ListResourceConfigValidators(ctx context.Context) []list.ConfigValidator {
+	if v, ok := w.inner.(list.ListResourceWithConfigValidators); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		if diags.HasError() {
+			tflog.Warn(ctx, "wrapping ListResourceConfigValidators", map[string]any{
+				"list resource":          w.opts.typeName,
+				"bootstrapContext error": fwdiag.DiagnosticsString(diags),
+			})
+
+			return nil
+		}
+
+		return v.ListResourceConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedListResource) ValidateListResourceConfig(ctx context.Context, request list.ValidateConfigRequest, response *list.ValidateConfigResponse) {
+	if v, ok := w.inner.(list.ListResourceWithValidateConfig); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateListResourceConfig(ctx, request, response)
+	}
+}
+
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
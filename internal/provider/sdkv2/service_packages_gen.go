@@ -239,6 +239,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmsap"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sso"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssooidc"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
@@ -494,6 +495,7 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		ssmsap.ServicePackage(ctx),
 		sso.ServicePackage(ctx),
 		ssoadmin.ServicePackage(ctx),
+		ssooidc.ServicePackage(ctx),
 		storagegateway.ServicePackage(ctx),
 		sts.ServicePackage(ctx),
 		swf.ServicePackage(ctx),
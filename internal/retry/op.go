@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 type opFunc[T any] func(context.Context) (T, error)
@@ -87,6 +88,11 @@ func (op opFunc[T]) If(predicate predicateFunc[T]) runFunc[T] {
 	return func(ctx context.Context, timeout time.Duration, opts ...backoff.Option) (T, error) {
 		// We explicitly don't set a deadline on the context here to maintain compatibility
 		// with the Plugin SDKv2 implementation. A parent context may have set a deadline.
+		if clock, ok := vcr.NewClockFromContext(ctx); ok {
+			// Any caller-specified clock or timer takes precedence.
+			opts = append([]backoff.Option{backoff.WithClock(clock)}, opts...)
+		}
+
 		var l *backoff.Loop
 		for l = backoff.NewLoopWithOptions(timeout, opts...); l.Continue(ctx); {
 			t, err := op(ctx)
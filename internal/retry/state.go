@@ -67,7 +67,9 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // Cancellation of the passed in context will cancel the refresh loop.
 //
-// When VCR testing is enabled in replay mode, the DelayFunc is overridden to
+// If the context carries a VCR clock function, a new clock is used to wait between state change refreshes
+// and to track the timeout. When replaying, waiting skips time rather than sleeping.
+// Otherwise, when VCR testing is enabled in replay mode, the DelayFunc is overridden to
 // allow interactions to be replayed with no delay between state change refreshes.
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
 	// Set a default for times to check for not found.
@@ -80,11 +82,15 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 
 	// Set a default DelayFunc using the StateChangeConf values
 	delayFunc := backoff.SDKv2HelperRetryCompatibleDelay(conf.Delay, conf.PollInterval, conf.MinTimeout)
+	opts := []backoff.Option{backoff.WithDelay(delayFunc)}
 
-	// When VCR testing in replay mode, override the default DelayFunc
-	if inContext, ok := conns.FromContext(ctx); ok && inContext.VCREnabled() {
+	if clock, ok := vcr.NewClockFromContext(ctx); ok {
+		// Use the VCR clock, which skips time when replaying.
+		opts = append(opts, backoff.WithClock(clock))
+	} else if inContext, ok := conns.FromContext(ctx); ok && inContext.VCREnabled() {
+		// When VCR testing in replay mode, override the default DelayFunc
 		if mode, _ := vcr.Mode(); mode == recorder.ModeReplayOnly {
-			delayFunc = backoff.ZeroDelay
+			opts = append(opts, backoff.WithDelay(backoff.ZeroDelay))
 		}
 	}
 
@@ -95,7 +101,7 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 		notFoundTick, targetOccurence int
 		l                             *backoff.Loop
 	)
-	for l = backoff.NewLoopWithOptions(conf.Timeout, backoff.WithDelay(delayFunc)); l.Continue(ctx); {
+	for l = backoff.NewLoopWithOptions(conf.Timeout, opts...); l.Continue(ctx); {
 		t, currentState, err = conf.refreshWithTimeout(ctx, l.Remaining())
 
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -7,11 +7,13 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 //
@@ -207,6 +209,101 @@ func TestWaitForState_success(t *testing.T) {
 	}
 }
 
+func TestWaitForState_successReplayClock(t *testing.T) {
+	t.Parallel()
+
+	r := NewStateGenerator([]string{"pending", "pending", "running"})
+	conf := &StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"running"},
+		Refresh: func(context.Context) (any, string, error) {
+			idx, s, err := r.NextState()
+			if err != nil {
+				return nil, "", err
+			}
+
+			return idx, s, nil
+		},
+		Delay:        1 * time.Minute,
+		PollInterval: 2 * time.Minute,
+		Timeout:      10 * time.Minute,
+	}
+
+	clock := vcr.NewReplayClock()
+	start := time.Now()
+
+	ctx := vcr.NewContextWithClockFunc(t.Context(), func() vcr.Clock {
+		return clock
+	})
+
+	obj, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if obj == nil {
+		t.Fatalf("should return obj")
+	}
+
+	if got, want := clock.Skipped(), 5*time.Minute; got != want {
+		t.Errorf("Skipped = %v, want %v", got, want)
+	}
+	if elapsed := time.Since(start); elapsed > 1*time.Second {
+		t.Errorf("Elapsed = %v, want < 1s", elapsed)
+	}
+}
+
+func TestWaitForState_concurrentReplayClocks(t *testing.T) {
+	t.Parallel()
+
+	const n = 4
+
+	ctx := vcr.NewContextWithClockFunc(t.Context(), func() vcr.Clock {
+		return vcr.NewReplayClock()
+	})
+
+	// Every waiter starts its timeout before any waiter skips time polling.
+	var started sync.WaitGroup
+	started.Add(n)
+
+	errs := make(chan error, n)
+	for range n {
+		go func() {
+			r := NewStateGenerator([]string{"pending", "pending", "pending", "pending", "running"})
+			var once sync.Once
+			conf := &StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"running"},
+				Refresh: func(context.Context) (any, string, error) {
+					once.Do(func() {
+						started.Done()
+						started.Wait()
+					})
+
+					idx, s, err := r.NextState()
+					if err != nil {
+						return nil, "", err
+					}
+
+					return idx, s, nil
+				},
+				Delay:        1 * time.Minute,
+				PollInterval: 2 * time.Minute,
+				// Each waiter skips 9 minutes, so a clock shared by all waiters would exceed the timeout.
+				Timeout: 10 * time.Minute,
+			}
+
+			_, err := conf.WaitForStateContext(ctx)
+			errs <- err
+		}()
+	}
+
+	for range n {
+		if err := <-errs; err != nil {
+			t.Errorf("err: %s", err)
+		}
+	}
+}
+
 func TestWaitForState_successUnknownPending(t *testing.T) {
 	t.Parallel()
 
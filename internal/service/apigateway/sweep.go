@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -24,27 +23,27 @@ func RegisterSweepers() {
 		"aws_api_gateway_rest_api",
 	)
 
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_api_gateway_rest_api", &awsv2.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_api_gateway_vpc_link", &awsv2.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_api_gateway_client_certificate", &awsv2.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_api_gateway_usage_plan", &awsv2.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_api_gateway_api_key", &awsv2.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -52,7 +51,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_api_gateway_domain_name", &awsv2.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
@@ -66,8 +65,7 @@ func sweepAccounts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepa
 	}, nil
 }
 
-func sweepRestAPIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestAPIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -107,8 +105,7 @@ func sweepRestAPIs(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -155,8 +152,7 @@ func sweepVPCLinks(region string) error {
 	return nil
 }
 
-func sweepClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -196,8 +192,7 @@ func sweepClientCertificates(region string) error {
 	return nil
 }
 
-func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsagePlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -238,8 +233,7 @@ func sweepUsagePlans(region string) error {
 	return nil
 }
 
-func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -279,8 +273,7 @@ func sweepAPIKeys(region string) error {
 	return nil
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
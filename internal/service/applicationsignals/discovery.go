@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkResource("aws_applicationsignals_discovery", name="Discovery")
+func newDiscoveryResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &discoveryResource{}
+
+	return r, nil
+}
+
+type discoveryResource struct {
+	framework.ResourceWithModel[discoveryResourceModel]
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+}
+
+func (r *discoveryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{},
+	}
+}
+
+func (r *discoveryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data discoveryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	// StartDiscovery creates the AWSServiceRoleForCloudWatchApplicationSignals service-linked role
+	// and is idempotent. There is no corresponding API to describe or disable discovery.
+	var input applicationsignals.StartDiscoveryInput
+	_, err := conn.StartDiscovery(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("starting Application Signals Discovery", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+type discoveryResourceModel struct {
+	framework.WithRegionModel
+}
@@ -0,0 +1,930 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/applicationsignals/types;awstypes;awstypes.ServiceLevelObjective")
+// @Testing(hasNoPreExistingResource=true)
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceLevelObjectiveResource{}
+
+	return r, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithModel[serviceLevelObjectiveResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	dependencyConfigBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dependencyConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"dependency_key_attributes": schema.MapAttribute{
+					CustomType:  fwtypes.MapOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				"dependency_operation_name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	metricDataQueryBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrAccountID: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrExpression: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrID: schema.StringAttribute{
+						Required: true,
+					},
+					"label": schema.StringAttribute{
+						Optional: true,
+					},
+					"period": schema.Int32Attribute{
+						Optional: true,
+					},
+					"return_data": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"metric_stat": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"period": schema.Int32Attribute{
+									Required: true,
+								},
+								"stat": schema.StringAttribute{
+									Required: true,
+								},
+								names.AttrUnit: schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+									Optional:   true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"metric": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrMetricName: schema.StringAttribute{
+												Optional: true,
+											},
+											names.AttrNamespace: schema.StringAttribute{
+												Optional: true,
+											},
+										},
+										Blocks: map[string]schema.Block{
+											"dimension": schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(30),
+												},
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrName: schema.StringAttribute{
+															Required: true,
+														},
+														names.AttrValue: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrInterval: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock,
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric":  metricDataQueryBlock(),
+												"good_count_metric": metricDataQueryBlock(),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int32Attribute{
+										Optional: true,
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock,
+									"metric_data_query": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("request_based_sli_config"),
+			path.MatchRoot("sli_config"),
+		),
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input applicationsignals.CreateServiceLevelObjectiveInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Signals Service Level Objective (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.Slo, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findServiceLevelObjectiveByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+		var input applicationsignals.UpdateServiceLevelObjectiveInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Id = aws.String(arn)
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Application Signals Service Level Objective (%s)", arn), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output.Slo, new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.LastUpdatedTime = old.LastUpdatedTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	tflog.Debug(ctx, "deleting Application Signals Service Level Objective", map[string]any{
+		names.AttrARN: arn,
+	})
+
+	input := applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(arn),
+	}
+	_, err := conn.DeleteServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Signals Service Level Objective (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func findServiceLevelObjectiveByARN(ctx context.Context, conn *applicationsignals.Client, arn string) (*awstypes.ServiceLevelObjective, error) {
+	input := applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(arn),
+	}
+	output, err := conn.GetServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.Slo, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configuration"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSLIConfig  fwtypes.ListNestedObjectValueOf[requestBasedSLIConfigModel] `tfsdk:"request_based_sli_config"`
+	SLIConfig              fwtypes.ListNestedObjectValueOf[sliConfigModel]             `tfsdk:"sli_config"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+// flatten sets the model from the specified SLO.
+// The API returns the SLI it built from the configuration (Sli/RequestBasedSli) rather than the configuration
+// itself (SliConfig/RequestBasedSliConfig), so the SLI is mapped back onto the configuration, using the prior
+// model for any values that are not returned.
+func (m *serviceLevelObjectiveResourceModel) flatten(ctx context.Context, slo *awstypes.ServiceLevelObjective, prior serviceLevelObjectiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, slo, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	m.SLIConfig, d = flattenServiceLevelIndicator(ctx, slo.Sli, prior.SLIConfig)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.RequestBasedSLIConfig, d = flattenRequestBasedServiceLevelIndicator(ctx, slo.RequestBasedSli, prior.RequestBasedSLIConfig)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func flattenServiceLevelIndicator(ctx context.Context, apiObject *awstypes.ServiceLevelIndicator, prior fwtypes.ListNestedObjectValueOf[sliConfigModel]) (fwtypes.ListNestedObjectValueOf[sliConfigModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[sliConfigModel](ctx), diags
+	}
+
+	var sliConfig sliConfigModel
+	diags.Append(fwflex.Flatten(ctx, apiObject, &sliConfig)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[sliConfigModel](ctx), diags
+	}
+
+	var sliMetricConfig sliMetricConfigModel
+	diags.Append(fwflex.Flatten(ctx, apiObject.SliMetric, &sliMetricConfig)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[sliConfigModel](ctx), diags
+	}
+
+	priorSLIConfig, d := prior.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[sliConfigModel](ctx), diags
+	}
+
+	if priorSLIConfig != nil {
+		priorSLIMetricConfig, d := priorSLIConfig.SLIMetricConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[sliConfigModel](ctx), diags
+		}
+
+		if priorSLIMetricConfig != nil {
+			// The period and statistic are not returned.
+			sliMetricConfig.PeriodSeconds = priorSLIMetricConfig.PeriodSeconds
+			sliMetricConfig.Statistic = priorSLIMetricConfig.Statistic
+
+			// When the SLI is defined by key attributes, the generated metric data queries are returned.
+			if priorSLIMetricConfig.MetricDataQueries.IsNull() {
+				sliMetricConfig.MetricDataQueries = priorSLIMetricConfig.MetricDataQueries
+			}
+		}
+	}
+
+	sliConfig.SLIMetricConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &sliMetricConfig)
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &sliConfig), diags
+}
+
+func flattenRequestBasedServiceLevelIndicator(ctx context.Context, apiObject *awstypes.RequestBasedServiceLevelIndicator, prior fwtypes.ListNestedObjectValueOf[requestBasedSLIConfigModel]) (fwtypes.ListNestedObjectValueOf[requestBasedSLIConfigModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIConfigModel](ctx), diags
+	}
+
+	var requestBasedSLIConfig requestBasedSLIConfigModel
+	diags.Append(fwflex.Flatten(ctx, apiObject, &requestBasedSLIConfig)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIConfigModel](ctx), diags
+	}
+
+	var requestBasedSLIMetricConfig requestBasedSLIMetricConfigModel
+	diags.Append(fwflex.Flatten(ctx, apiObject.RequestBasedSliMetric, &requestBasedSLIMetricConfig)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIConfigModel](ctx), diags
+	}
+
+	priorRequestBasedSLIConfig, d := prior.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIConfigModel](ctx), diags
+	}
+
+	if priorRequestBasedSLIConfig != nil {
+		priorRequestBasedSLIMetricConfig, d := priorRequestBasedSLIConfig.RequestBasedSLIMetricConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[requestBasedSLIConfigModel](ctx), diags
+		}
+
+		if priorRequestBasedSLIMetricConfig != nil {
+			// When the SLI is defined by key attributes, the generated metric data queries are returned.
+			if priorRequestBasedSLIMetricConfig.MonitoredRequestCountMetric.IsNull() {
+				requestBasedSLIMetricConfig.MonitoredRequestCountMetric = priorRequestBasedSLIMetricConfig.MonitoredRequestCountMetric
+			}
+			if priorRequestBasedSLIMetricConfig.TotalRequestCountMetric.IsNull() {
+				requestBasedSLIMetricConfig.TotalRequestCountMetric = priorRequestBasedSLIMetricConfig.TotalRequestCountMetric
+			}
+		}
+	}
+
+	requestBasedSLIConfig.RequestBasedSLIMetricConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &requestBasedSLIMetricConfig)
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &requestBasedSLIConfig), diags
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int32 `tfsdk:"look_back_window_minutes"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var v any
+
+	switch {
+	case !m.CalendarInterval.IsNull():
+		data, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var apiObject awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		v = &apiObject
+	case !m.RollingInterval.IsNull():
+		data, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var apiObject awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		v = &apiObject
+	}
+
+	return v, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var data calendarIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	case awstypes.IntervalMemberRollingInterval:
+		var data rollingIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type sliConfigModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SLIMetricConfig    fwtypes.ListNestedObjectValueOf[sliMetricConfigModel]                `tfsdk:"sli_metric_config"`
+}
+
+type sliMetricConfigModel struct {
+	DependencyConfig  fwtypes.ListNestedObjectValueOf[dependencyConfigModel]       `tfsdk:"dependency_config"`
+	KeyAttributes     fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int32                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type requestBasedSLIConfigModel struct {
+	ComparisonOperator          fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold             types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSLIMetricConfig fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricConfigModel]    `tfsdk:"request_based_sli_metric_config"`
+}
+
+type requestBasedSLIMetricConfigModel struct {
+	DependencyConfig            fwtypes.ListNestedObjectValueOf[dependencyConfigModel]            `tfsdk:"dependency_config"`
+	KeyAttributes               fwtypes.MapOfString                                               `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var v any
+
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var apiObject awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		v = &apiObject
+	case !m.GoodCountMetric.IsNull():
+		var apiObject awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		v = &apiObject
+	}
+
+	return v, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+	}
+
+	return diags
+}
+
+type dependencyConfigModel struct {
+	DependencyKeyAttributes fwtypes.MapOfString `tfsdk:"dependency_key_attributes"`
+	DependencyOperationName types.String        `tfsdk:"dependency_operation_name"`
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int32                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int32                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -21,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_athena_data_catalog", &awsv2.Sweeper{
 		Name: "aws_athena_data_catalog",
 		F:    sweepDataCatalogs,
 		Dependencies: []string{
@@ -29,12 +28,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_athena_database", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_athena_database", &awsv2.Sweeper{
 		Name: "aws_athena_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_athena_workgroup", &awsv2.Sweeper{
 		Name: "aws_athena_workgroup",
 		F:    sweepWorkGroups,
 		Dependencies: []string{
@@ -76,8 +75,7 @@ func sweepCapacityReservations(ctx context.Context, client *conns.AWSClient) ([]
 	return sweepResources, nil
 }
 
-func sweepDataCatalogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepDataCatalogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -124,8 +122,7 @@ func sweepDataCatalogs(region string) error {
 	return nil
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -189,8 +186,7 @@ func sweepDatabases(region string) error {
 	return nil
 }
 
-func sweepWorkGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
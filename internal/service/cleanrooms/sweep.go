@@ -4,12 +4,12 @@
 package cleanrooms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -18,22 +18,21 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cleanrooms_collaboration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cleanrooms_collaboration", &awsv2.Sweeper{
 		Name: "aws_cleanrooms_collaboration",
 		F:    sweepCollaborations,
 	})
-	resource.AddTestSweepers("aaws_cleanrooms_configured_table", &resource.Sweeper{
+	awsv2.AddTestSweepers("aaws_cleanrooms_configured_table", &awsv2.Sweeper{
 		Name: "aws_cleanrooms_configured_table",
 		F:    sweepConfiguredTables,
 	})
-	resource.AddTestSweepers("aaws_cleanrooms_membership", &resource.Sweeper{
+	awsv2.AddTestSweepers("aaws_cleanrooms_membership", &awsv2.Sweeper{
 		Name: "aws_cleanrooms_membership",
 		F:    sweepMemberships,
 	})
 }
 
-func sweepCollaborations(region string) error {
-	ctx := sweep.Context(region)
+func sweepCollaborations(ctx context.Context, region string) error {
 
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
@@ -73,8 +72,7 @@ func sweepCollaborations(region string) error {
 	return nil
 }
 
-func sweepConfiguredTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfiguredTables(ctx context.Context, region string) error {
 
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
@@ -114,8 +112,7 @@ func sweepConfiguredTables(region string) error {
 	return nil
 }
 
-func sweepMemberships(region string) error {
-	ctx := sweep.Context(region)
+func sweepMemberships(ctx context.Context, region string) error {
 
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
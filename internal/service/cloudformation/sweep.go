@@ -153,24 +153,30 @@ func sweepStacks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 		for _, v := range page.StackSummaries {
 			name := aws.ToString(v.StackName)
-			input := cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
-
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, &input)
-
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
-			}
-
 			r := resourceStack()
 			d := r.Data(nil)
 			d.SetId(name)
+			sweepable := sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Name:      name,
+				CreatedAt: aws.ToTime(v.CreationTime),
+			})
+
+			if !sweep.SkipResourceAction(ctx, sweep.Describe(ctx, sweepable), "disable termination protection for CloudFormation Stack %s", name) {
+				input := cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   aws.String(name),
+				}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
+				_, err := conn.UpdateTerminationProtection(ctx, &input)
+
+				if err != nil {
+					log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
+					continue
+				}
+			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -29,3 +29,8 @@ const (
 	vpcOriginStatusDeployed  = "Deployed"
 	vpcOriginStatusDeploying = "Deploying"
 )
+
+const (
+	invalidationStatusCompleted  = "Completed"
+	invalidationStatusInProgress = "InProgress"
+)
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @Action("aws_cloudfront_create_invalidation", name="Create Invalidation")
+func newCreateInvalidationAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createInvalidationAction{}, nil
+}
+
+const (
+	createInvalidationDefaultTimeout = 15 * time.Minute
+)
+
+type createInvalidationAction struct {
+	framework.ActionWithModel[createInvalidationActionModel]
+}
+
+func (a *createInvalidationAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Creates a CloudFront invalidation and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"caller_reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "Unique value that ensures that the request can't be replayed. Generated if not specified.",
+			},
+			"distribution_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the CloudFront distribution to invalidate.",
+			},
+			"paths": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Paths to invalidate. Each path must start with `/`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Time in seconds to wait for the invalidation to complete. Defaults to 900.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *createInvalidationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var config createInvalidationActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	distributionID := fwflex.StringValueFromFramework(ctx, config.DistributionID)
+	paths := fwflex.ExpandFrameworkStringValueList(ctx, config.Paths)
+	callerReference := fwflex.StringValueFromFramework(ctx, config.CallerReference)
+	if callerReference == "" {
+		callerReference = id.UniqueId()
+	}
+	timeout := createInvalidationDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	input := cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &awstypes.InvalidationBatch{
+			CallerReference: aws.String(callerReference),
+			Paths: &awstypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	}
+
+	tflog.Info(ctx, "Creating CloudFront Invalidation", map[string]any{
+		"distribution_id": distributionID,
+		"paths":           paths,
+	})
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating invalidation for CloudFront Distribution %s...", distributionID),
+	})
+
+	output, err := conn.CreateInvalidation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Distribution (%s) Invalidation", distributionID), err.Error())
+
+		return
+	}
+
+	invalidationID := aws.ToString(output.Invalidation.Id)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for CloudFront Distribution %s Invalidation %s to complete...", distributionID, invalidationID),
+	})
+
+	if _, err := waitInvalidationCompleted(ctx, conn, distributionID, invalidationID, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution (%s) Invalidation (%s) complete", distributionID, invalidationID), err.Error())
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CloudFront Distribution %s Invalidation %s completed", distributionID, invalidationID),
+	})
+}
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) (*awstypes.Invalidation, error) {
+	input := cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(invalidationID),
+	}
+
+	output, err := conn.GetInvalidation(ctx, &input)
+
+	if errs.IsA[*awstypes.NoSuchInvalidation](err) || errs.IsA[*awstypes.NoSuchDistribution](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+func statusInvalidation(conn *cloudfront.Client, distributionID, invalidationID string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string, timeout time.Duration) (*awstypes.Invalidation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{invalidationStatusInProgress},
+		Target:     []string{invalidationStatusCompleted},
+		Refresh:    statusInvalidation(conn, distributionID, invalidationID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type createInvalidationActionModel struct {
+	CallerReference types.String         `tfsdk:"caller_reference"`
+	DistributionID  types.String         `tfsdk:"distribution_id"`
+	Paths           fwtypes.ListOfString `tfsdk:"paths"`
+	Timeout         types.Int64          `tfsdk:"timeout"`
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontCreateInvalidationAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	callerReference := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateInvalidationActionConfig_basic(callerReference),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckCreateInvalidationActionInvalidationCompleted(ctx, &distribution, callerReference, []string{"/index.html", "/images/*"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateInvalidationActionInvalidationCompleted(ctx context.Context, distribution *awstypes.Distribution, callerReference string, paths []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+		distributionID := aws.ToString(distribution.Id)
+
+		input := cloudfront.ListInvalidationsInput{
+			DistributionId: aws.String(distributionID),
+		}
+		pages := cloudfront.NewListInvalidationsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.InvalidationList.Items {
+				invalidation, err := tfcloudfront.FindInvalidationByTwoPartKey(ctx, conn, distributionID, aws.ToString(v.Id))
+
+				if err != nil {
+					return err
+				}
+
+				if aws.ToString(invalidation.InvalidationBatch.CallerReference) != callerReference {
+					continue
+				}
+
+				if got, want := aws.ToString(invalidation.Status), "Completed"; got != want {
+					return fmt.Errorf("CloudFront Distribution (%s) Invalidation (%s) status = %s, want %s", distributionID, aws.ToString(invalidation.Id), got, want)
+				}
+
+				if got, want := slices.Sorted(slices.Values(invalidation.InvalidationBatch.Paths.Items)), slices.Sorted(slices.Values(paths)); !slices.Equal(got, want) {
+					return fmt.Errorf("CloudFront Distribution (%s) Invalidation (%s) paths = %v, want %v", distributionID, aws.ToString(invalidation.Id), got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("CloudFront Distribution (%s) Invalidation with caller reference %s not found", distributionID, callerReference)
+	}
+}
+
+func testAccCreateInvalidationActionConfig_basic(callerReference string) string {
+	return acctest.ConfigCompose(testAccDistributionConfig_enabled(true, false), fmt.Sprintf(`
+action "aws_cloudfront_create_invalidation" "test" {
+  config {
+    distribution_id  = aws_cloudfront_distribution.test.id
+    caller_reference = %[1]q
+    paths            = ["/index.html", "/images/*"]
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_cloudfront_distribution.test.etag
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudfront_create_invalidation.test]
+    }
+  }
+}
+`, callerReference))
+}
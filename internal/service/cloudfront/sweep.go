@@ -4,6 +4,7 @@
 package cloudfront
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -20,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_cache_policy", &awsv2.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -29,22 +29,22 @@ func RegisterSweepers() {
 	})
 
 	// DO NOT add a continuous deployment policy sweeper as these are swept as part of the distribution sweeper
-	// resource.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
+	// awsv2.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &awsv2.Sweeper{
 	//	Name: "aws_cloudfront_continuous_deployment_policy",
 	//	F:    sweepContinuousDeploymentPolicies,
 	//})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_distribution", &awsv2.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &awsv2.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &awsv2.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -52,17 +52,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_function", &awsv2.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_key_group", &awsv2.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_monitoring_subscription", &awsv2.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 		Dependencies: []string{
@@ -70,7 +70,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_origin_access_control", &awsv2.Sweeper{
 		Name: "aws_cloudfront_origin_access_control",
 		F:    sweepOriginAccessControls,
 		Dependencies: []string{
@@ -78,7 +78,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_origin_request_policy", &awsv2.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -86,12 +86,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_realtime_log_config", &awsv2.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_response_headers_policy", &awsv2.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -99,14 +99,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_vpc_origin", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudfront_vpc_origin", &awsv2.Sweeper{
 		Name: "aws_cloudfront_vpc_origin",
 		F:    sweepVPCOrigins,
 	})
 }
 
-func sweepCachePolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepCachePolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -163,29 +162,28 @@ func sweepCachePolicies(region string) error {
 	return nil
 }
 
-func sweepDistributions(region string) error {
+func sweepDistributions(ctx context.Context, region string) error {
 	var errs []error
 
 	// 1. Production Distributions
-	if err := sweepDistributionsByProductionOrStaging(region, false); err != nil {
+	if err := sweepDistributionsByProductionOrStaging(ctx, region, false); err != nil {
 		errs = append(errs, err)
 	}
 
 	// 2. Continuous Deployment Policies
-	if err := sweepContinuousDeploymentPolicies(region); err != nil {
+	if err := sweepContinuousDeploymentPolicies(ctx, region); err != nil {
 		errs = append(errs, err)
 	}
 
 	// 3. Staging Distributions
-	if err := sweepDistributionsByProductionOrStaging(region, true); err != nil {
+	if err := sweepDistributionsByProductionOrStaging(ctx, region, true); err != nil {
 		errs = append(errs, err)
 	}
 
 	return errors.Join(errs...)
 }
 
-func sweepDistributionsByProductionOrStaging(region string, staging bool) error {
-	ctx := sweep.Context(region)
+func sweepDistributionsByProductionOrStaging(ctx context.Context, region string, staging bool) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -247,8 +245,7 @@ func sweepDistributionsByProductionOrStaging(region string, staging bool) error
 	return nil
 }
 
-func sweepContinuousDeploymentPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepContinuousDeploymentPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -289,8 +286,7 @@ func sweepContinuousDeploymentPolicies(region string) error {
 	return nil
 }
 
-func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
+func sweepFunctions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -345,8 +341,7 @@ func sweepFunctions(region string) error {
 	return nil
 }
 
-func sweepKeyGroup(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeyGroup(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -401,8 +396,7 @@ func sweepKeyGroup(region string) error {
 	return nil
 }
 
-func sweepMonitoringSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepMonitoringSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -442,8 +436,7 @@ func sweepMonitoringSubscriptions(region string) error {
 	return nil
 }
 
-func sweepRealtimeLogsConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepRealtimeLogsConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -486,8 +479,7 @@ func sweepRealtimeLogsConfig(region string) error {
 	return nil
 }
 
-func sweepFieldLevelEncryptionConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFieldLevelEncryptionConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -542,8 +534,7 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 	return nil
 }
 
-func sweepFieldLevelEncryptionProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepFieldLevelEncryptionProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -598,8 +589,7 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 	return nil
 }
 
-func sweepOriginRequestPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepOriginRequestPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -656,8 +646,7 @@ func sweepOriginRequestPolicies(region string) error {
 	return nil
 }
 
-func sweepResponseHeadersPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepResponseHeadersPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -714,8 +703,7 @@ func sweepResponseHeadersPolicies(region string) error {
 	return nil
 }
 
-func sweepOriginAccessControls(region string) error {
-	ctx := sweep.Context(region)
+func sweepOriginAccessControls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -770,8 +758,7 @@ func sweepOriginAccessControls(region string) error {
 	return nil
 }
 
-func sweepVPCOrigins(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCOrigins(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,25 +4,24 @@
 package codegurureviewer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codegurureviewer"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codegurureviewer", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_codegurureviewer", &awsv2.Sweeper{
 		Name: "aws_codegurureviewer",
 		F:    sweepAssociations,
 	})
 }
 
-func sweepAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
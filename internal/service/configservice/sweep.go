@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -20,12 +19,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_aggregate_authorization", &awsv2.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_config_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_config_rule", &awsv2.Sweeper{
 		Name: "aws_config_config_rule",
 		F:    sweepConfigRules,
 		Dependencies: []string{
@@ -33,22 +32,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_configuration_aggregator", &awsv2.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_configuration_recorder", &awsv2.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_conformance_pack", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_conformance_pack", &awsv2.Sweeper{
 		Name: "aws_config_conformance_pack",
 		F:    sweepConformancePacks,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_delivery_channel", &awsv2.Sweeper{
 		Name: "aws_config_delivery_channel",
 		F:    sweepDeliveryChannels,
 		Dependencies: []string{
@@ -56,14 +55,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_remediation_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_remediation_configuration", &awsv2.Sweeper{
 		Name: "aws_config_remediation_configuration",
 		F:    sweepRemediationConfigurations,
 	})
 }
 
-func sweepAggregateAuthorizations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAggregateAuthorizations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -103,8 +101,7 @@ func sweepAggregateAuthorizations(region string) error {
 	return nil
 }
 
-func sweepConfigRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -151,8 +148,7 @@ func sweepConfigRules(region string) error {
 	return nil
 }
 
-func sweepConfigurationAggregators(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationAggregators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -213,8 +209,7 @@ func (s *configurationRecorderSweeper) Delete(ctx context.Context, optFns ...tfr
 	return sdk.NewSweepResource(r, d, s.client).Delete(ctx, optFns...)
 }
 
-func sweepConfigurationRecorder(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationRecorder(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -247,8 +242,7 @@ func sweepConfigurationRecorder(region string) error {
 	return nil
 }
 
-func sweepConformancePacks(region string) error {
-	ctx := sweep.Context(region)
+func sweepConformancePacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -288,8 +282,7 @@ func sweepConformancePacks(region string) error {
 	return nil
 }
 
-func sweepDeliveryChannels(region string) error {
-	ctx := sweep.Context(region)
+func sweepDeliveryChannels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -326,8 +319,7 @@ func sweepDeliveryChannels(region string) error {
 	return nil
 }
 
-func sweepRemediationConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepRemediationConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -10,6 +10,7 @@ var (
 	ResourceContactFlowModule         = resourceContactFlowModule
 	ResourceHoursOfOperation          = resourceHoursOfOperation
 	ResourceInstance                  = resourceInstance
+	ResourceIntegrationAssociation    = resourceIntegrationAssociation
 	ResourceInstanceStorageConfig     = resourceInstanceStorageConfig
 	ResourceLambdaFunctionAssociation = resourceLambdaFunctionAssociation
 	ResourcePhoneNumber               = resourcePhoneNumber
@@ -28,6 +29,7 @@ var (
 	FindHoursOfOperationByTwoPartKey          = findHoursOfOperationByTwoPartKey
 	FindInstanceByID                          = findInstanceByID
 	FindInstanceStorageConfigByThreePartKey   = findInstanceStorageConfigByThreePartKey
+	FindIntegrationAssociationByTwoPartKey    = findIntegrationAssociationByTwoPartKey
 	FindLambdaFunctionAssociationByTwoPartKey = findLambdaFunctionAssociationByTwoPartKey
 	FindPhoneNumberByID                       = findPhoneNumberByID
 	FindQueueByTwoPartKey                     = findQueueByTwoPartKey
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	integrationAssociationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_connect_integration_association", name="Integration Association")
+func resourceIntegrationAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationAssociationCreate,
+		ReadWithoutTimeout:   resourceIntegrationAssociationRead,
+		DeleteWithoutTimeout: resourceIntegrationAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"integration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"integration_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.IntegrationType](),
+			},
+			"source_application_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"source_application_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+			"source_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.SourceType](),
+			},
+		},
+	}
+}
+
+func resourceIntegrationAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	input := &connect.CreateIntegrationAssociationInput{
+		InstanceId:      aws.String(instanceID),
+		IntegrationArn:  aws.String(d.Get("integration_arn").(string)),
+		IntegrationType: awstypes.IntegrationType(d.Get("integration_type").(string)),
+	}
+
+	if v, ok := d.GetOk("source_application_name"); ok {
+		input.SourceApplicationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_application_url"); ok {
+		input.SourceApplicationUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_type"); ok {
+		input.SourceType = awstypes.SourceType(v.(string))
+	}
+
+	output, err := conn.CreateIntegrationAssociation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Integration Association (%s): %s", instanceID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{instanceID, aws.ToString(output.IntegrationAssociationId)}, integrationAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceIntegrationAssociationRead(ctx, d, meta)...)
+}
+
+func resourceIntegrationAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), integrationAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	instanceID, integrationAssociationID := parts[0], parts[1]
+	integrationAssociation, err := findIntegrationAssociationByTwoPartKey(ctx, conn, instanceID, integrationAssociationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Integration Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, integrationAssociation.IntegrationAssociationArn)
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set("integration_arn", integrationAssociation.IntegrationArn)
+	d.Set("integration_association_id", integrationAssociation.IntegrationAssociationId)
+	d.Set("integration_type", integrationAssociation.IntegrationType)
+	d.Set("source_application_name", integrationAssociation.SourceApplicationName)
+	d.Set("source_application_url", integrationAssociation.SourceApplicationUrl)
+	d.Set("source_type", integrationAssociation.SourceType)
+
+	return diags
+}
+
+func resourceIntegrationAssociationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), integrationAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	instanceID, integrationAssociationID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting Connect Integration Association: %s", d.Id())
+	input := connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(instanceID),
+		IntegrationAssociationId: aws.String(integrationAssociationID),
+	}
+	_, err = conn.DeleteIntegrationAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIntegrationAssociationByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, integrationAssociationID string) (*awstypes.IntegrationAssociationSummary, error) {
+	const maxResults = 100
+	input := &connect.ListIntegrationAssociationsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int32(maxResults),
+	}
+
+	return findIntegrationAssociation(ctx, conn, input, func(v *awstypes.IntegrationAssociationSummary) bool {
+		return aws.ToString(v.IntegrationAssociationId) == integrationAssociationID
+	})
+}
+
+func findIntegrationAssociation(ctx context.Context, conn *connect.Client, input *connect.ListIntegrationAssociationsInput, filter tfslices.Predicate[*awstypes.IntegrationAssociationSummary]) (*awstypes.IntegrationAssociationSummary, error) {
+	output, err := findIntegrationAssociations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIntegrationAssociations(ctx context.Context, conn *connect.Client, input *connect.ListIntegrationAssociationsInput, filter tfslices.Predicate[*awstypes.IntegrationAssociationSummary]) ([]awstypes.IntegrationAssociationSummary, error) {
+	var output []awstypes.IntegrationAssociationSummary
+
+	pages := connect.NewListIntegrationAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.IntegrationAssociationSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,526 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recipeVersionLatestPublished = "LATEST_PUBLISHED"
+	recipeVersionLatestWorking   = "LATEST_WORKING"
+
+	recipeVersionsBatchDeleteMaxSize = 50
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(hasNoPreExistingResource=true)
+func newRecipeResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &recipeResource{}, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"latest_published_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"recipe_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1024),
+										},
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input databrew.CreateRecipeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Glue DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	if data.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name, fwflex.StringFromFramework(ctx, data.Description)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Glue DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(readRecipeVersions(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findRecipeByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Glue DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Resource"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	latestPublishedVersion, err := findLatestPublishedRecipeVersionByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Glue DataBrew Recipe (%s) latest published version", name), err.Error())
+
+		return
+	}
+
+	data.LatestPublishedVersion = fwflex.StringToFramework(ctx, latestPublishedVersion)
+	if data.Publish.IsNull() {
+		data.Publish = types.BoolValue(false)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Publish"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := fwflex.StringValueFromFramework(ctx, new.Name)
+
+	if diff.HasChanges() {
+		var input databrew.UpdateRecipeInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Glue DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	// Publish a new version when the working version has changed or publishing has just been enabled.
+	if new.Publish.ValueBool() && (diff.HasChanges() || !old.Publish.ValueBool()) {
+		if err := publishRecipe(ctx, conn, name, fwflex.StringFromFramework(ctx, new.Description)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Glue DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(readRecipeVersions(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	tflog.Debug(ctx, "deleting Glue DataBrew Recipe", map[string]any{
+		names.AttrName: name,
+	})
+
+	if err := deleteRecipe(ctx, conn, name); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Glue DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+}
+
+// readRecipeVersions sets the computed version attributes from the recipe's current state.
+func readRecipeVersions(ctx context.Context, conn *databrew.Client, data *recipeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findRecipeByName(ctx, conn, name)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Glue DataBrew Recipe (%s)", name), err.Error())
+
+		return diags
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+	data.RecipeVersion = fwflex.StringToFramework(ctx, output.RecipeVersion)
+
+	latestPublishedVersion, err := findLatestPublishedRecipeVersionByName(ctx, conn, name)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Glue DataBrew Recipe (%s) latest published version", name), err.Error())
+
+		return diags
+	}
+
+	data.LatestPublishedVersion = fwflex.StringToFramework(ctx, latestPublishedVersion)
+
+	return diags
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name string, description *string) error {
+	input := databrew.PublishRecipeInput{
+		Description: description,
+		Name:        aws.String(name),
+	}
+	_, err := conn.PublishRecipe(ctx, &input)
+
+	return err
+}
+
+// deleteRecipe deletes all published versions of the specified recipe followed by its working version.
+func deleteRecipe(ctx context.Context, conn *databrew.Client, name string) error {
+	versions, err := findRecipeVersionsByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing versions: %w", err)
+	}
+
+	for chunk := range slices.Chunk(versions, recipeVersionsBatchDeleteMaxSize) {
+		input := databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		}
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting published versions: %w", err)
+		}
+
+		if err := recipeVersionErrorsError(output.Errors); err != nil {
+			return fmt.Errorf("deleting published versions: %w", err)
+		}
+	}
+
+	input := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	_, err = conn.DeleteRecipeVersion(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func recipeVersionErrorsError(apiObjects []awstypes.RecipeVersionErrorDetail) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.RecipeVersion), aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findRecipeByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name: aws.String(name),
+	}
+
+	return findRecipe(ctx, conn, &input)
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+
+	return findRecipe(ctx, conn, &input)
+}
+
+func findRecipe(ctx context.Context, conn *databrew.Client, input *databrew.DescribeRecipeInput) (*databrew.DescribeRecipeOutput, error) {
+	output, err := conn.DescribeRecipe(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findLatestPublishedRecipeVersionByName returns the latest published version of the specified recipe, or nil if it has never been published.
+func findLatestPublishedRecipeVersionByName(ctx context.Context, conn *databrew.Client, name string) (*string, error) {
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestPublished)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.RecipeVersion, nil
+}
+
+// findRecipeVersionsByName returns the published versions of the specified recipe.
+func findRecipeVersionsByName(ctx context.Context, conn *databrew.Client, name string) ([]string, error) {
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []string
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Recipes {
+			output = append(output, aws.ToString(v.RecipeVersion))
+		}
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                     `tfsdk:"arn"`
+	Description            types.String                                     `tfsdk:"description"`
+	LatestPublishedVersion types.String                                     `tfsdk:"latest_published_version"`
+	Name                   types.String                                     `tfsdk:"name"`
+	Publish                types.Bool                                       `tfsdk:"publish"`
+	RecipeVersion          types.String                                     `tfsdk:"recipe_version"`
+	Steps                  fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags                   tftags.Map                                       `tfsdk:"tags"`
+	TagsAll                tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -19,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_datasync_agent", &awsv2.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 		Dependencies: []string{
@@ -28,7 +27,7 @@ func RegisterSweepers() {
 	})
 
 	// Pseudo-resource for any DataSync location resource type.
-	resource.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_datasync_location", &awsv2.Sweeper{
 		Name: "aws_datasync_location",
 		F:    sweepLocations,
 		Dependencies: []string{
@@ -36,14 +35,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_datasync_task", &awsv2.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
 }
 
-func sweepAgents(region string) error {
-	ctx := sweep.Context(region)
+func sweepAgents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -83,8 +81,7 @@ func sweepAgents(region string) error {
 	return nil
 }
 
-func sweepLocations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLocations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -130,6 +127,13 @@ type sweepableLocation struct {
 	conn *datasync.Client
 }
 
+// Metadata describes the DataSync Location.
+func (sweepable *sweepableLocation) Metadata(ctx context.Context) sweep.Metadata {
+	return sweep.Metadata{
+		ID: sweepable.arn,
+	}
+}
+
 func (sweepable *sweepableLocation) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	log.Printf("[DEBUG] Deleting DataSync Location: %s", sweepable.arn)
 	input := datasync.DeleteLocationInput{
@@ -148,8 +152,7 @@ func (sweepable *sweepableLocation) Delete(ctx context.Context, optFns ...tfreso
 	return nil
 }
 
-func sweepTasks(region string) error {
-	ctx := sweep.Context(region)
+func sweepTasks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
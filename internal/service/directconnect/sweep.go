@@ -4,6 +4,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -13,23 +14,22 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_connection", &awsv2.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_gateway_association_proposal", &awsv2.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_gateway_association", &awsv2.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_gateway", &awsv2.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -46,21 +46,20 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_lag", &awsv2.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_macsec_key", &awsv2.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -97,8 +96,7 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepGatewayAssociationProposals(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayAssociationProposals(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -153,8 +151,7 @@ func sweepGatewayAssociationProposals(region string) error {
 	return nil
 }
 
-func sweepGatewayAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -290,8 +287,7 @@ func sweepGatewayAssociations(region string) error {
 	return nil
 }
 
-func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -374,8 +370,7 @@ func sweepGateways(region string) error {
 	return nil
 }
 
-func sweepLags(region string) error {
-	ctx := sweep.Context(region)
+func sweepLags(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -412,8 +407,7 @@ func sweepLags(region string) error {
 	return nil
 }
 
-func sweepMacSecKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepMacSecKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -442,6 +436,10 @@ func sweepMacSecKeys(region string) error {
 		for _, v := range v.MacSecKeys {
 			arn := aws.ToString(v.SecretARN)
 
+			if sweep.SkipResourceAction(ctx, sweep.Metadata{ID: arn}, "delete MACsec secret key %s", arn) {
+				continue
+			}
+
 			input := &secretsmanager.DeleteSecretInput{
 				SecretId: aws.String(arn),
 			}
@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -22,19 +21,18 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dynamodb_table", &awsv2.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dynamodb_backup", &awsv2.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
 }
 
-func sweepTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -57,16 +55,6 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			input := dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			}
-			_, err := conn.UpdateTable(ctx, &input)
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
-			}
-
 			r := resourceTable()
 			d := r.Data(nil)
 			d.SetId(v)
@@ -78,8 +66,21 @@ func sweepTables(region string) error {
 			if d.Id() == "" {
 				continue
 			}
+			sweepable := sweep.NewSweepResource(r, d, client)
+
+			if !sweep.SkipResourceAction(ctx, sweep.Describe(ctx, sweepable), "disable deletion protection for DynamoDB Table %s", v) {
+				input := dynamodb.UpdateTableInput{
+					DeletionProtectionEnabled: aws.Bool(false),
+					TableName:                 aws.String(v),
+				}
+				_, err := conn.UpdateTable(ctx, &input)
+
+				if err != nil {
+					log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
+				}
+			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -92,8 +93,7 @@ func sweepTables(region string) error {
 	return nil
 }
 
-func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
+func sweepBackups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -149,6 +149,13 @@ type backupSweeper struct {
 	arn  string
 }
 
+// Metadata describes the DynamoDB Backup.
+func (bs backupSweeper) Metadata(ctx context.Context) sweep.Metadata {
+	return sweep.Metadata{
+		ID: bs.arn,
+	}
+}
+
 func (bs backupSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	input := &dynamodb.DeleteBackupInput{
 		BackupArn: aws.String(bs.arn),
@@ -47,8 +47,10 @@ import (
 
 // @SDKResource("aws_instance", name="Instance")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
+// @Testing(preIdentityVersion="6.4.0")
 // @Testing(generator=false)
 func resourceInstance() *schema.Resource {
 	//lintignore:R011
@@ -58,10 +60,6 @@ func resourceInstance() *schema.Resource {
 		UpdateWithoutTimeout: resourceInstanceUpdate,
 		DeleteWithoutTimeout: resourceInstanceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaVersion: 2,
 		MigrateState:  instanceMigrateState,
 		StateUpgraders: []schema.StateUpgrader{
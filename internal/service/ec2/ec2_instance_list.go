@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+// @IdentityAttribute("id")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	return &instanceListResource{}
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listResourceWithFiltersSchema(ctx)
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query listResourceWithFiltersModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input := ec2.DescribeInstancesInput{
+		Filters: newCustomListFilterList(ctx, query.Filters),
+	}
+
+	tflog.Info(ctx, "Listing Instances")
+	instances := listPages(ctx, ec2.NewDescribeInstancesPaginator(conn, &input), func(page *ec2.DescribeInstancesOutput) []awstypes.Instance {
+		var instances []awstypes.Instance
+
+		for _, v := range page.Reservations {
+			for _, v := range v.Instances {
+				// Terminated instances remain visible for a short time after termination.
+				if v.State != nil && v.State.Name == awstypes.InstanceStateNameTerminated {
+					continue
+				}
+
+				instances = append(instances, v)
+			}
+		}
+
+		return instances
+	})
+	stream.Results = listResults(ctx, awsClient, &l.ListResourceWithSDKv2Resource, request, instances, func(v awstypes.Instance) listedResource {
+		return listedResource{id: aws.ToString(v.InstanceId), tags: v.Tags}
+	}, "tf_aws.instance_id", "Instances")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -159,14 +161,40 @@ func customFiltersBlock(ctx context.Context) datasourceschema.Block {
 	}
 }
 
+// customListFiltersBlock is the List Resource variant of customFiltersSchema.
+func customListFiltersBlock(ctx context.Context) listschema.Block {
+	return listschema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
 // customFilterModel represents a single configured filter.
 type customFilterModel struct {
 	Name   types.String        `tfsdk:"name"`
 	Values fwtypes.SetOfString `tfsdk:"values"`
 }
 
+// customListFilterModel represents a single filter configured on a List Resource.
+type customListFilterModel struct {
+	Name   types.String         `tfsdk:"name"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
 type (
-	customFilters = fwtypes.SetNestedObjectValueOf[customFilterModel]
+	customFilters     = fwtypes.SetNestedObjectValueOf[customFilterModel]
+	customListFilters = fwtypes.ListNestedObjectValueOf[customListFilterModel]
 )
 
 // newCustomFilterList takes the set value extracted from a schema
@@ -218,6 +246,34 @@ func newCustomFilterListFramework(ctx context.Context, customFilters customFilte
 	return filters
 }
 
+// newCustomListFilterList transforms the filters configured on a List Resource into a []awstypes.Filter.
+// Filters with the same name are merged.
+func newCustomListFilterList(ctx context.Context, customFilters customListFilters) []awstypes.Filter {
+	if customFilters.IsNull() || customFilters.IsUnknown() {
+		return nil
+	}
+
+	filters := make(namevaluesfilters.NameValuesFilters)
+
+	for _, v := range customFilters.Elements() {
+		var data customListFilterModel
+
+		if tfsdk.ValueAs(ctx, v, &data).HasError() {
+			continue
+		}
+
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		filters.Add(map[string][]string{
+			data.Name.ValueString(): fwflex.ExpandFrameworkStringValueList(ctx, data.Values),
+		})
+	}
+
+	return filters.EC2Filters()
+}
+
 // newAttributeFilterList takes a flat map of scalar attributes (most
 // likely values extracted from a *schema.ResourceData on an EC2-querying
 // data source) and produces a []*ec2.Filter representing an exact match
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// listResourceWithFiltersModel is the configuration of a List Resource that supports EC2 filters.
+type listResourceWithFiltersModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+// listResourceWithFiltersSchema returns the configuration schema of a List Resource that supports EC2 filters.
+func listResourceWithFiltersSchema(ctx context.Context) listschema.Schema {
+	return listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+// pager is implemented by the EC2 Describe* paginators.
+type pager[O any] interface {
+	HasMorePages() bool
+	NextPage(context.Context, ...func(*ec2.Options)) (O, error)
+}
+
+// listPages returns an iterator over the items of all pages returned by the specified paginator.
+// Iteration stops after the first error.
+func listPages[O, T any](ctx context.Context, pages pager[O], items func(O) []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, v := range items(page) {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// listedResource describes a resource returned by an EC2 Describe* API.
+type listedResource struct {
+	id   string
+	tags []awstypes.Tag
+}
+
+// listResults returns the List Resource results for the specified resources.
+// If the request includes the full resource, the Plugin SDK resource's Read handler is called for each resource.
+// idField is the name of the log field used for resource IDs and typeName is the plural resource type name used in error messages.
+func listResults[T any](ctx context.Context, awsClient *conns.AWSClient, l *framework.ListResourceWithSDKv2Resource, request list.ListRequest, resources iter.Seq2[T, error], describe func(T) listedResource, idField, typeName string) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		for v, err := range resources {
+			if err != nil {
+				result := request.NewListResult(ctx)
+				result.Diagnostics.AddError("listing EC2 "+typeName, err.Error())
+				yield(result)
+				return
+			}
+
+			resource := describe(v)
+			ctx := tflog.SetField(ctx, idField, resource.id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(resource.id)
+
+			if request.IncludeResource {
+				result.Diagnostics.Append(l.ReadResource(ctx, awsClient, rd)...)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				// The resource may have been deleted since it was listed.
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = listResultDisplayName(ctx, resource.id, resource.tags)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// listResultDisplayName returns the display name for a listed resource.
+// This is the value of the resource's "Name" tag, falling back to the resource's ID.
+func listResultDisplayName(ctx context.Context, id string, tags []awstypes.Tag) string {
+	if v := aws.ToString(keyValueTags(ctx, tags).KeyValue("Name")); v != "" {
+		return v
+	}
+
+	return id
+}
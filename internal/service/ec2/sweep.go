@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -25,7 +24,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_customer_gateway", &awsv2.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -33,17 +32,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_capacity_reservation", &awsv2.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_carrier_gateway", &awsv2.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_client_vpn_endpoint", &awsv2.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -51,17 +50,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_client_vpn_network_association", &awsv2.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_fleet", &awsv2.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ebs_volume", &awsv2.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -69,7 +68,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ebs_snapshot", &awsv2.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -77,12 +76,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_egress_only_internet_gateway", &awsv2.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eip", &awsv2.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -91,17 +90,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eip_domain_name", &awsv2.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_flow_log", &awsv2.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_host", &awsv2.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -109,7 +108,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_instance", &awsv2.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -119,7 +118,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_internet_gateway", &awsv2.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -127,7 +126,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_key_pair", &awsv2.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -138,7 +137,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_launch_template", &awsv2.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -147,17 +146,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_nat_gateway", &awsv2.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_network_acl", &awsv2.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_network_interface", &awsv2.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -176,7 +175,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_managed_prefix_list", &awsv2.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -186,12 +185,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_network_insights_path", &awsv2.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_placement_group", &awsv2.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -203,12 +202,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route_table", &awsv2.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_security_group", &awsv2.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -216,12 +215,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_spot_fleet_request", &awsv2.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_spot_instance_request", &awsv2.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -276,7 +275,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_traffic_mirror_filter", &awsv2.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -284,12 +283,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_traffic_mirror_session", &awsv2.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_traffic_mirror_target", &awsv2.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -297,17 +296,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &awsv2.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &awsv2.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_transit_gateway", &awsv2.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -319,12 +318,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &awsv2.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_transit_gateway_connect", &awsv2.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -333,7 +332,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &awsv2.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -342,12 +341,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc_dhcp_options", &awsv2.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc_endpoint", &awsv2.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -357,12 +356,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &awsv2.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc_endpoint_service", &awsv2.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -370,12 +369,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc_peering_connection", &awsv2.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc", &awsv2.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -396,12 +395,12 @@ func RegisterSweepers() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpn_connection", &awsv2.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpn_gateway", &awsv2.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -414,22 +413,22 @@ func RegisterSweepers() {
 	awsv2.Register("aws_vpc_ipam", sweepIPAMs)
 	awsv2.Register("aws_vpc_ipam_resource_discovery", sweepIPAMResourceDiscoveries)
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ami", &awsv2.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &awsv2.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ec2_instance_connect_endpoint", &awsv2.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_verifiedaccess_trust_provider", &awsv2.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -437,7 +436,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &awsv2.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -445,7 +444,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_verifiedaccess_group", &awsv2.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -453,12 +452,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_verifiedaccess_endpoint", &awsv2.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_verifiedaccess_instance", &awsv2.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
@@ -473,8 +472,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_vpc_route_server_propagation", sweepRouteServerPropagations)
 }
 
-func sweepCapacityReservations(region string) error {
-	ctx := sweep.Context(region)
+func sweepCapacityReservations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -502,6 +500,15 @@ func sweepCapacityReservations(region string) error {
 		if r.State != awstypes.CapacityReservationStateCancelled && r.State != awstypes.CapacityReservationStateExpired {
 			id := aws.ToString(r.CapacityReservationId)
 
+			m := sweep.Metadata{
+				ID:        id,
+				Tags:      keyValueTags(ctx, r.Tags).Map(),
+				CreatedAt: aws.ToTime(r.CreateDate),
+			}
+			if sweep.SkipResourceAction(ctx, m, "cancel EC2 Capacity Reservation %s", id) {
+				continue
+			}
+
 			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
 
 			input := ec2.CancelCapacityReservationInput{
@@ -519,8 +526,7 @@ func sweepCapacityReservations(region string) error {
 	return nil
 }
 
-func sweepCarrierGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCarrierGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -560,8 +566,7 @@ func sweepCarrierGateways(region string) error {
 	return nil
 }
 
-func sweepClientVPNEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -601,8 +606,7 @@ func sweepClientVPNEndpoints(region string) error {
 	return nil
 }
 
-func sweepClientVPNNetworkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNNetworkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -663,8 +667,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -709,8 +712,7 @@ func sweepFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEBSVolumes(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSVolumes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -757,8 +759,7 @@ func sweepEBSVolumes(region string) error {
 	return nil
 }
 
-func sweepEBSSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -800,8 +801,7 @@ func sweepEBSSnapshots(region string) error {
 	return nil
 }
 
-func sweepEgressOnlyInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepEgressOnlyInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -841,8 +841,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 	return nil
 }
 
-func sweepEIPs(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -891,8 +890,7 @@ func sweepEIPs(region string) error {
 	return nil
 }
 
-func sweepEIPDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -932,8 +930,7 @@ func sweepEIPDomainNames(region string) error {
 	return nil
 }
 
-func sweepFlowLogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFlowLogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -973,8 +970,7 @@ func sweepFlowLogs(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+func sweepHosts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1014,8 +1010,7 @@ func sweepHosts(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1046,15 +1041,21 @@ func sweepInstances(region string) error {
 					continue
 				}
 
-				if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
-					log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
-				}
-
 				r := resourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
+				sweepable := sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+					Tags:      keyValueTags(ctx, v.Tags).Map(),
+					CreatedAt: aws.ToTime(v.LaunchTime),
+				})
+
+				if !sweep.SkipResourceAction(ctx, sweep.Describe(ctx, sweepable), "disable API stop protection for EC2 Instance %s", id) {
+					if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
+						log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+					}
+				}
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweepable)
 			}
 		}
 	}
@@ -1068,8 +1069,7 @@ func sweepInstances(region string) error {
 	return nil
 }
 
-func sweepInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1148,8 +1148,7 @@ func sweepInternetGateways(region string) error {
 	return nil
 }
 
-func sweepKeyPairs(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeyPairs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1186,8 +1185,7 @@ func sweepKeyPairs(region string) error {
 	return nil
 }
 
-func sweepLaunchTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepLaunchTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1227,8 +1225,7 @@ func sweepLaunchTemplates(region string) error {
 	return nil
 }
 
-func sweepNATGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepNATGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1255,7 +1252,10 @@ func sweepNATGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.NatGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Tags:      keyValueTags(ctx, v.Tags).Map(),
+				CreatedAt: aws.ToTime(v.CreateTime),
+			}))
 		}
 	}
 
@@ -1268,8 +1268,7 @@ func sweepNATGateways(region string) error {
 	return nil
 }
 
-func sweepNetworkACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1321,8 +1320,7 @@ func sweepNetworkACLs(region string) error {
 	return nil
 }
 
-func sweepNetworkInterfaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInterfaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1369,8 +1367,7 @@ func sweepNetworkInterfaces(region string) error {
 	return nil
 }
 
-func sweepManagedPrefixLists(region string) error {
-	ctx := sweep.Context(region)
+func sweepManagedPrefixLists(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1414,8 +1411,7 @@ func sweepManagedPrefixLists(region string) error {
 	return nil
 }
 
-func sweepNetworkInsightsPaths(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInsightsPaths(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1455,8 +1451,7 @@ func sweepNetworkInsightsPaths(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPlacementGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlacementGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1493,8 +1488,7 @@ func sweepPlacementGroups(region string) error {
 	return nil
 }
 
-func sweepRouteTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1522,6 +1516,14 @@ func sweepRouteTables(region string) error {
 			id := aws.ToString(routeTable.RouteTableId)
 			isMainRouteTableAssociation := false
 
+			m := sweep.Metadata{
+				ID:   id,
+				Tags: keyValueTags(ctx, routeTable.Tags).Map(),
+			}
+			if sweep.SkipResourceAction(ctx, m, "delete EC2 Route Table %s, or the routes of a main route table, and its associations", id) {
+				continue
+			}
+
 			for _, routeTableAssociation := range routeTable.Associations {
 				if aws.ToBool(routeTableAssociation.Main) {
 					isMainRouteTableAssociation = true
@@ -1596,8 +1598,7 @@ func sweepRouteTables(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1627,6 +1628,15 @@ func sweepSecurityGroups(region string) error {
 				continue
 			}
 
+			m := sweep.Metadata{
+				ID:   aws.ToString(sg.GroupId),
+				Name: aws.ToString(sg.GroupName),
+				Tags: keyValueTags(ctx, sg.Tags).Map(),
+			}
+			if sweep.SkipResourceAction(ctx, m, "revoke all rules of EC2 Security Group %s", aws.ToString(sg.GroupId)) {
+				continue
+			}
+
 			if sg.IpPermissions != nil {
 				input := ec2.RevokeSecurityGroupIngressInput{
 					GroupId:       sg.GroupId,
@@ -1670,6 +1680,15 @@ func sweepSecurityGroups(region string) error {
 				continue
 			}
 
+			m := sweep.Metadata{
+				ID:   aws.ToString(sg.GroupId),
+				Name: aws.ToString(sg.GroupName),
+				Tags: keyValueTags(ctx, sg.Tags).Map(),
+			}
+			if sweep.SkipResourceAction(ctx, m, "delete EC2 Security Group %s", aws.ToString(sg.GroupId)) {
+				continue
+			}
+
 			input := ec2.DeleteSecurityGroupInput{
 				GroupId: sg.GroupId,
 			}
@@ -1696,8 +1715,7 @@ func sweepSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepSpotFleetRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotFleetRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1745,8 +1763,7 @@ func sweepSpotFleetRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSpotInstanceRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotInstanceRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1817,15 +1834,16 @@ func sweepSubnets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.SubnetId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Tags: keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
 	return sweepResources, nil
 }
 
-func sweepTrafficMirrorFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1865,8 +1883,7 @@ func sweepTrafficMirrorFilters(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorSessions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorSessions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1906,8 +1923,7 @@ func sweepTrafficMirrorSessions(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1947,8 +1963,7 @@ func sweepTrafficMirrorTargets(region string) error {
 	return nil
 }
 
-func sweepTransitGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1992,8 +2007,7 @@ func sweepTransitGateways(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnectPeers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnectPeers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2037,8 +2051,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnects(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2082,8 +2095,7 @@ func sweepTransitGatewayConnects(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayMulticastDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayMulticastDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2127,8 +2139,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeeringAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeeringAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2172,8 +2183,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2217,8 +2227,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCDHCPOptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCDHCPOptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2287,8 +2296,7 @@ func sweepVPCDHCPOptions(region string) error {
 	return nil
 }
 
-func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2342,8 +2350,7 @@ func sweepVPCEndpoints(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointConnectionAccepters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointConnectionAccepters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2387,8 +2394,7 @@ func sweepVPCEndpointConnectionAccepters(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2437,8 +2443,7 @@ func sweepVPCEndpointServices(region string) error {
 	return nil
 }
 
-func sweepVPCPeeringConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCPeeringConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2480,8 +2485,7 @@ func sweepVPCPeeringConnections(region string) error {
 	return nil
 }
 
-func sweepVPCs(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2515,7 +2519,9 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.VpcId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Tags: keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
@@ -2528,8 +2534,7 @@ func sweepVPCs(region string) error {
 	return nil
 }
 
-func sweepVPNConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2572,8 +2577,7 @@ func sweepVPNConnections(region string) error {
 	return nil
 }
 
-func sweepVPNGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2622,8 +2626,7 @@ func sweepVPNGateways(region string) error {
 	return nil
 }
 
-func sweepCustomerGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomerGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2734,8 +2737,7 @@ func sweepIPAMResourceDiscoveries(ctx context.Context, client *conns.AWSClient)
 	return sweepResources, nil
 }
 
-func sweepAMIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepAMIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2779,8 +2781,7 @@ func sweepAMIs(region string) error {
 	return nil
 }
 
-func sweepNetworkPerformanceMetricSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkPerformanceMetricSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2823,8 +2824,7 @@ func sweepNetworkPerformanceMetricSubscriptions(region string) error {
 	return nil
 }
 
-func sweepInstanceConnectEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstanceConnectEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2866,8 +2866,7 @@ func sweepInstanceConnectEndpoints(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2909,8 +2908,7 @@ func sweepVerifiedAccessEndpoints(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2952,8 +2950,7 @@ func sweepVerifiedAccessGroups(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2995,8 +2992,7 @@ func sweepVerifiedAccessInstances(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessTrustProviders(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessTrustProviders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -3038,8 +3034,7 @@ func sweepVerifiedAccessTrustProviders(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessTrustProviderAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessTrustProviderAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
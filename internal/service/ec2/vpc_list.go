@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_vpc", name="VPC")
+// @IdentityAttribute("id")
+func newVPCResourceAsListResource() inttypes.ListResourceForSDK {
+	return &vpcListResource{}
+}
+
+type vpcListResource struct {
+	framework.ListResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *vpcListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listResourceWithFiltersSchema(ctx)
+}
+
+func (l *vpcListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query listResourceWithFiltersModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input := ec2.DescribeVpcsInput{
+		Filters: newCustomListFilterList(ctx, query.Filters),
+	}
+
+	tflog.Info(ctx, "Listing VPCs")
+	vpcs := listPages(ctx, ec2.NewDescribeVpcsPaginator(conn, &input), func(page *ec2.DescribeVpcsOutput) []awstypes.Vpc {
+		return page.Vpcs
+	})
+	stream.Results = listResults(ctx, awsClient, &l.ListResourceWithSDKv2Resource, request, vpcs, func(v awstypes.Vpc) listedResource {
+		return listedResource{id: aws.ToString(v.VpcId), tags: v.Tags}
+	}, "tf_aws.vpc_id", "VPCs")
+}
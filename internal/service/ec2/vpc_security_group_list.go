@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_security_group", name="Security Group")
+// @IdentityAttribute("id")
+func newSecurityGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	return &securityGroupListResource{}
+}
+
+type securityGroupListResource struct {
+	framework.ListResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listResourceWithFiltersSchema(ctx)
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query listResourceWithFiltersModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input := ec2.DescribeSecurityGroupsInput{
+		Filters: newCustomListFilterList(ctx, query.Filters),
+	}
+
+	tflog.Info(ctx, "Listing Security Groups")
+	securityGroups := listPages(ctx, ec2.NewDescribeSecurityGroupsPaginator(conn, &input), func(page *ec2.DescribeSecurityGroupsOutput) []awstypes.SecurityGroup {
+		return page.SecurityGroups
+	})
+	stream.Results = listResults(ctx, awsClient, &l.ListResourceWithSDKv2Resource, request, securityGroups, func(v awstypes.SecurityGroup) listedResource {
+		return listedResource{id: aws.ToString(v.GroupId), tags: v.Tags}
+	}, "tf_aws.security_group_id", "Security Groups")
+}
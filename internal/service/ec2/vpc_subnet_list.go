@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_subnet", name="Subnet")
+// @IdentityAttribute("id")
+func newSubnetResourceAsListResource() inttypes.ListResourceForSDK {
+	return &subnetListResource{}
+}
+
+type subnetListResource struct {
+	framework.ListResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *subnetListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listResourceWithFiltersSchema(ctx)
+}
+
+func (l *subnetListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query listResourceWithFiltersModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	input := ec2.DescribeSubnetsInput{
+		Filters: newCustomListFilterList(ctx, query.Filters),
+	}
+
+	tflog.Info(ctx, "Listing Subnets")
+	subnets := listPages(ctx, ec2.NewDescribeSubnetsPaginator(conn, &input), func(page *ec2.DescribeSubnetsOutput) []awstypes.Subnet {
+		return page.Subnets
+	})
+	stream.Results = listResults(ctx, awsClient, &l.ListResourceWithSDKv2Resource, request, subnets, func(v awstypes.Subnet) listedResource {
+		return listedResource{id: aws.ToString(v.SubnetId), tags: v.Tags}
+	}, "tf_aws.subnet_id", "Subnets")
+}
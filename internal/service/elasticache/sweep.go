@@ -14,10 +14,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -28,7 +28,7 @@ const (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_cluster", &awsv2.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -36,12 +36,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_global_replication_group", &awsv2.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_parameter_group", &awsv2.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -50,7 +50,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_replication_group", &awsv2.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_subnet_group", &awsv2.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -67,7 +67,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_user", &awsv2.Sweeper{
 		Name: "aws_elasticache_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -75,19 +75,18 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_user_group", &awsv2.Sweeper{
 		Name: "aws_elasticache_user_group",
 		F:    sweepUserGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_serverless_cache", &awsv2.Sweeper{
 		Name: "aws_elasticache_serverless_cache",
 		F:    sweepServerlessCaches,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -96,7 +95,7 @@ func sweepClusters(region string) error {
 		ShowCacheClustersNotInReplicationGroups: aws.Bool(true),
 	}
 	conn := client.ElastiCacheClient(ctx)
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := elasticache.NewDescribeCacheClustersPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -104,39 +103,34 @@ func sweepClusters(region string) error {
 
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping ElastiCache Cluster sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving ElastiCache Clusters: %w", err))
+			return fmt.Errorf("error listing ElastiCache Clusters (%s): %w", region, err)
 		}
 
 		for _, v := range page.CacheClusters {
-			id := aws.ToString(v.CacheClusterId)
+			r := resourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.CacheClusterId))
 
-			log.Printf("[INFO] Deleting ElastiCache Cluster: %s", id)
-			err := deleteCacheCluster(ctx, conn, id, "")
+			sweepResources = append(sweepResources, sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				CreatedAt: aws.ToTime(v.CacheClusterCreateTime),
+			}))
+		}
+	}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Cluster (%s): %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err))
-			}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			const (
-				timeout = 40 * time.Minute
-			)
-			if _, err := waitCacheClusterDeleted(ctx, conn, id, timeout); err != nil {
-				log.Printf("[ERROR] Failed waiting for ElastiCache Cache Cluster (%s) to be deleted: %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err))
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Clusters (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
-func sweepGlobalReplicationGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGlobalReplicationGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -145,47 +139,36 @@ func sweepGlobalReplicationGroups(region string) error {
 		ShowMemberInfo: aws.Bool(true),
 	}
 	conn := client.ElastiCacheClient(ctx)
-
-	var grgGroup multierror.Group
-	var grgErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := elasticache.NewDescribeGlobalReplicationGroupsPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %q: %s", region, err)
-			return grgErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %s: %s", region, err)
+			return nil
 		}
 
 		if err != nil {
-			grgErrs = multierror.Append(grgErrs, fmt.Errorf("listing ElastiCache Global Replication Groups: %w", err))
+			return fmt.Errorf("listing ElastiCache Global Replication Groups (%s): %w", region, err)
 		}
 
-		for _, globalReplicationGroup := range page.GlobalReplicationGroups {
-			grgGroup.Go(func() error {
-				id := aws.ToString(globalReplicationGroup.GlobalReplicationGroupId)
-
-				disassociationErrors := disassociateMembers(ctx, conn, globalReplicationGroup)
-				if disassociationErrors != nil {
-					return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, disassociationErrors)
-				}
-
-				log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
-				err := deleteGlobalReplicationGroup(ctx, conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
-
-				return err
-			})
+		for _, v := range page.GlobalReplicationGroups {
+			sweepResources = append(sweepResources, newGlobalReplicationGroupSweeper(conn, v))
 		}
 	}
 
-	grgErrs = multierror.Append(grgErrs, grgGroup.Wait())
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-	return grgErrs.ErrorOrNil()
+	if err != nil {
+		return fmt.Errorf("sweeping ElastiCache Global Replication Groups (%s): %w", region, err)
+	}
+
+	return nil
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepParameterGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -232,8 +215,7 @@ func sweepParameterGroups(region string) error {
 	return nil
 }
 
-func sweepReplicationGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -276,8 +258,7 @@ func sweepReplicationGroups(region string) error {
 	return nil
 }
 
-func sweepServerlessCaches(region string) error {
-	ctx := sweep.Context(region)
+func sweepServerlessCaches(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -315,8 +296,7 @@ func sweepServerlessCaches(region string) error {
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -363,8 +343,7 @@ func sweepSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -411,8 +390,7 @@ func sweepUsers(region string) error {
 	return nil
 }
 
-func sweepUserGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepUserGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -452,6 +430,37 @@ func sweepUserGroups(region string) error {
 	return nil
 }
 
+type globalReplicationGroupSweeper struct {
+	conn                   *elasticache.Client
+	globalReplicationGroup awstypes.GlobalReplicationGroup
+}
+
+func newGlobalReplicationGroupSweeper(conn *elasticache.Client, globalReplicationGroup awstypes.GlobalReplicationGroup) sweep.Sweepable {
+	return &globalReplicationGroupSweeper{
+		conn:                   conn,
+		globalReplicationGroup: globalReplicationGroup,
+	}
+}
+
+// Metadata describes the ElastiCache Global Replication Group.
+func (s globalReplicationGroupSweeper) Metadata(ctx context.Context) sweep.Metadata {
+	return sweep.Metadata{
+		ID: aws.ToString(s.globalReplicationGroup.GlobalReplicationGroupId),
+	}
+}
+
+// Delete disassociates the Global Replication Group's secondary members and then deletes it.
+func (s globalReplicationGroupSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	id := aws.ToString(s.globalReplicationGroup.GlobalReplicationGroupId)
+
+	if err := disassociateMembers(ctx, s.conn, s.globalReplicationGroup); err != nil {
+		return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, err)
+	}
+
+	log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
+	return deleteGlobalReplicationGroup(ctx, s.conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
+}
+
 func disassociateMembers(ctx context.Context, conn *elasticache.Client, globalReplicationGroup awstypes.GlobalReplicationGroup) error {
 	var membersGroup multierror.Group
 
@@ -4,6 +4,7 @@
 package emr
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,25 +12,23 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/emr"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_emr_cluster", &awsv2.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_emr_studio", &awsv2.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -56,21 +55,23 @@ func sweepClusters(region string) error {
 
 		for _, v := range page.Clusters {
 			id := aws.ToString(v.Id)
-
-			_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
-				JobFlowIds:           []string{id},
-				TerminationProtected: aws.Bool(false),
-			})
-
-			if err != nil {
-				log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
-			}
-
 			r := resourceCluster()
 			d := r.Data(nil)
 			d.SetId(id)
+			sweepable := sweep.NewSweepResource(r, d, client)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			if !sweep.SkipResourceAction(ctx, sweep.Describe(ctx, sweepable), "disable termination protection for EMR Cluster %s", id) {
+				_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
+					JobFlowIds:           []string{id},
+					TerminationProtected: aws.Bool(false),
+				})
+
+				if err != nil {
+					log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				}
+			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -83,8 +84,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepStudios(region string) error {
-	ctx := sweep.Context(region)
+func sweepStudios(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_evs_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+func newEnvironmentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &environmentDataSource{}, nil
+}
+
+type environmentDataSource struct {
+	framework.DataSourceWithModel[environmentDataSourceModel]
+}
+
+func (d *environmentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"environment_id": schema.StringAttribute{
+				Required: true,
+			},
+			"hosts": framework.DataSourceComputedListOfObjectAttribute[hostModel](ctx),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"service_access_subnet_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"site_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentState](),
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckResult](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"vcf_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VcfVersion](),
+				Computed:   true,
+			},
+			"vlans": framework.DataSourceComputedListOfObjectAttribute[vlanModel](ctx),
+			names.AttrVPCID: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *environmentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data environmentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EVSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.EnvironmentID)
+	environment, err := findEnvironmentByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s)", id), err.Error())
+
+		return
+	}
+
+	hosts, err := findEnvironmentHostsByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s) hosts", id), err.Error())
+
+		return
+	}
+
+	vlans, err := findEnvironmentVLANsByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s) VLANs", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, environment, &data, fwflex.WithFieldNamePrefix("Environment"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, hosts, &data.Hosts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, vlans, &data.VLANs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findEnvironmentHostsByID(ctx context.Context, conn *evs.Client, id string) ([]awstypes.Host, error) {
+	input := evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(id),
+	}
+	var output []awstypes.Host
+
+	pages := evs.NewListEnvironmentHostsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EnvironmentHosts...)
+	}
+
+	return output, nil
+}
+
+func findEnvironmentVLANsByID(ctx context.Context, conn *evs.Client, id string) ([]awstypes.Vlan, error) {
+	input := evs.ListEnvironmentVlansInput{
+		EnvironmentId: aws.String(id),
+	}
+	var output []awstypes.Vlan
+
+	pages := evs.NewListEnvironmentVlansPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EnvironmentVlans...)
+	}
+
+	return output, nil
+}
+
+type environmentDataSourceModel struct {
+	framework.WithRegionModel
+	ARN                   types.String                                  `tfsdk:"arn"`
+	EnvironmentID         types.String                                  `tfsdk:"environment_id"`
+	Hosts                 fwtypes.ListNestedObjectValueOf[hostModel]    `tfsdk:"hosts"`
+	KMSKeyID              types.String                                  `tfsdk:"kms_key_id"`
+	Name                  types.String                                  `tfsdk:"name"`
+	ServiceAccessSubnetID types.String                                  `tfsdk:"service_access_subnet_id"`
+	SiteID                types.String                                  `tfsdk:"site_id"`
+	State                 fwtypes.StringEnum[awstypes.EnvironmentState] `tfsdk:"state"`
+	Status                fwtypes.StringEnum[awstypes.CheckResult]      `tfsdk:"status"`
+	Tags                  tftags.Map                                    `tfsdk:"tags"`
+	VcfVersion            fwtypes.StringEnum[awstypes.VcfVersion]       `tfsdk:"vcf_version"`
+	VLANs                 fwtypes.ListNestedObjectValueOf[vlanModel]    `tfsdk:"vlans"`
+	VPCID                 types.String                                  `tfsdk:"vpc_id"`
+}
+
+type hostModel struct {
+	DedicatedHostID   types.String                                           `tfsdk:"dedicated_host_id"`
+	HostName          types.String                                           `tfsdk:"host_name"`
+	HostState         fwtypes.StringEnum[awstypes.HostState]                 `tfsdk:"host_state"`
+	InstanceType      fwtypes.StringEnum[awstypes.InstanceType]              `tfsdk:"instance_type"`
+	IPAddress         types.String                                           `tfsdk:"ip_address"`
+	KeyName           types.String                                           `tfsdk:"key_name"`
+	NetworkInterfaces fwtypes.ListNestedObjectValueOf[networkInterfaceModel] `tfsdk:"network_interfaces"`
+	PlacementGroupID  types.String                                           `tfsdk:"placement_group_id"`
+}
+
+type networkInterfaceModel struct {
+	NetworkInterfaceID types.String `tfsdk:"network_interface_id"`
+}
+
+type vlanModel struct {
+	AvailabilityZone types.String                           `tfsdk:"availability_zone"`
+	CIDR             types.String                           `tfsdk:"cidr"`
+	FunctionName     types.String                           `tfsdk:"function_name"`
+	SubnetID         types.String                           `tfsdk:"subnet_id"`
+	VLANID           types.Int32                            `tfsdk:"vlan_id"`
+	VLANState        fwtypes.StringEnum[awstypes.VlanState] `tfsdk:"vlan_state"`
+}
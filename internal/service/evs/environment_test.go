@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// EVS environments require a pre-provisioned VPC route server peering, an EC2 key pair,
+// VCF license keys and a Broadcom site ID, so these are supplied via environment variables.
+const (
+	envVarKeyName             = "EVS_KEY_NAME"
+	envVarRouteServerPeerID   = "EVS_ROUTE_SERVER_PEER_ID"
+	envVarSiteID              = "EVS_SITE_ID"
+	envVarSolutionKey         = "EVS_SOLUTION_KEY"
+	envVarVSANKey             = "EVS_VSAN_KEY"
+	envVarServiceAccessSubnet = "EVS_SERVICE_ACCESS_SUBNET_ID"
+)
+
+type environmentTestConfig struct {
+	keyName               string
+	routeServerPeerID     string
+	serviceAccessSubnetID string
+	siteID                string
+	solutionKey           string
+	vsanKey               string
+}
+
+func testAccEnvironmentTestConfigFromEnv(t *testing.T) environmentTestConfig {
+	t.Helper()
+
+	return environmentTestConfig{
+		keyName:               acctest.SkipIfEnvVarNotSet(t, envVarKeyName),
+		routeServerPeerID:     acctest.SkipIfEnvVarNotSet(t, envVarRouteServerPeerID),
+		serviceAccessSubnetID: acctest.SkipIfEnvVarNotSet(t, envVarServiceAccessSubnet),
+		siteID:                acctest.SkipIfEnvVarNotSet(t, envVarSiteID),
+		solutionKey:           acctest.SkipIfEnvVarNotSet(t, envVarSolutionKey),
+		vsanKey:               acctest.SkipIfEnvVarNotSet(t, envVarVSANKey),
+	}
+}
+
+func TestAccEVSEnvironment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	cfg := testAccEnvironmentTestConfigFromEnv(t)
+	var environment awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("evs", regexache.MustCompile(`environment/.+$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("credentials"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("vcf_version"), tfknownvalue.StringExact(awstypes.VcfVersionVcf521)),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"host", "initial_vlans", names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	cfg := testAccEnvironmentTestConfigFromEnv(t)
+	var environment awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfevs.ResourceEnvironment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentExists(ctx context.Context, n string, v *awstypes.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+	input := evs.ListEnvironmentsInput{}
+	_, err := conn.ListEnvironments(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccEnvironmentConfig_basic(rName string, cfg environmentTestConfig) string {
+	return fmt.Sprintf(`
+data "aws_subnet" "service_access" {
+  id = %[2]q
+}
+
+resource "aws_ec2_placement_group" "test" {
+  name     = %[1]q
+  strategy = "partition"
+}
+
+resource "aws_evs_environment" "test" {
+  name                     = %[1]q
+  service_access_subnet_id = data.aws_subnet.service_access.id
+  site_id                  = %[4]q
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.1"
+  vpc_id                   = data.aws_subnet.service_access.vpc_id
+
+  connectivity_info {
+    private_route_server_peerings = [%[3]q]
+  }
+
+  dynamic "host" {
+    for_each = range(4)
+
+    content {
+      host_name          = "esx${host.value}"
+      instance_type      = "i4i.metal"
+      key_name           = %[5]q
+      placement_group_id = aws_ec2_placement_group.test.placement_group_id
+    }
+  }
+
+  initial_vlans {
+    edge_vtep {
+      cidr = "10.10.1.0/24"
+    }
+    hcx {
+      cidr = "10.10.2.0/24"
+    }
+    nsx_uplink {
+      cidr = "10.10.3.0/24"
+    }
+    vm_management {
+      cidr = "10.10.4.0/24"
+    }
+    vmk_management {
+      cidr = "10.10.5.0/24"
+    }
+    vmotion {
+      cidr = "10.10.6.0/24"
+    }
+    vsan {
+      cidr = "10.10.7.0/24"
+    }
+    vtep {
+      cidr = "10.10.8.0/24"
+    }
+  }
+
+  license_info {
+    solution_key = %[6]q
+    vsan_key     = %[7]q
+  }
+
+  vcf_hostnames {
+    cloud_builder = "cb"
+    nsx           = "nsx"
+    sddc_manager  = "sddcm"
+    vcenter       = "vc"
+  }
+}
+`, rName, cfg.serviceAccessSubnetID, cfg.routeServerPeerID, cfg.siteID, cfg.keyName, cfg.solutionKey, cfg.vsanKey)
+}
@@ -4,18 +4,18 @@
 package globalaccelerator
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_globalaccelerator_accelerator", &awsv2.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_globalaccelerator_listener", &awsv2.Sweeper{
 		Name: "aws_globalaccelerator_listener",
 		F:    sweepListeners,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_globalaccelerator_endpoint_group", &awsv2.Sweeper{
 		Name: "aws_globalaccelerator_endpoint_group",
 		F:    sweepEndpointGroups,
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &awsv2.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_accelerator",
 		F:    sweepCustomRoutingAccelerators,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &awsv2.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_listener",
 		F:    sweepCustomRoutingListeners,
 		Dependencies: []string{
@@ -52,14 +52,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &awsv2.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_endpoint_group",
 		F:    sweepCustomRoutingEndpointGroups,
 	})
 }
 
-func sweepAccelerators(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccelerators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -99,8 +98,7 @@ func sweepAccelerators(region string) error {
 	return nil
 }
 
-func sweepEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpointGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -170,8 +168,7 @@ func sweepEndpointGroups(region string) error {
 	return nil
 }
 
-func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -226,8 +223,7 @@ func sweepListeners(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingAccelerators(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingAccelerators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -267,8 +263,7 @@ func sweepCustomRoutingAccelerators(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingEndpointGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -338,8 +333,7 @@ func sweepCustomRoutingEndpointGroups(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
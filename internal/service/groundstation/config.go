@@ -0,0 +1,804 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_config", name="Config")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @IdentityAttribute("config_type")
+// @ImportIDHandler("configImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/groundstation;groundstation.GetConfigOutput")
+// @Testing(importStateIdFunc="testAccConfigImportStateIDFunc")
+// @Testing(hasNoPreExistingResource=true)
+func newConfigResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &configResource{}, nil
+}
+
+type configResource struct {
+	framework.ResourceWithModel[configResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *configResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	// A config's type can't be changed in place.
+	requiresReplaceIfConfigTypeChanged := listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			response.RequiresReplace = request.StateValue.IsNull() != request.PlanValue.IsNull()
+		},
+		"Changing the config type requires replacement",
+		"Changing the config type requires replacement",
+	)
+	configDataMemberBlock := func(nestedObject schema.NestedBlockObject, customType basetypes.ListTypable, validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: customType,
+			Validators: append([]validator.List{
+				listvalidator.SizeAtMost(1),
+			}, validators...),
+			PlanModifiers: []planmodifier.List{
+				requiresReplaceIfConfigTypeChanged,
+			},
+			NestedObject: nestedObject,
+		}
+	}
+	frequencyBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[frequencyModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrUnit: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FrequencyUnits](),
+					Required:   true,
+				},
+				names.AttrValue: schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	spectrumConfigBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[spectrumConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"polarization": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+					Optional:   true,
+					Computed:   true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"bandwidth": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[frequencyBandwidthModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrUnit: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.BandwidthUnits](),
+								Required:   true,
+							},
+							names.AttrValue: schema.Float64Attribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"center_frequency": frequencyBlock,
+			},
+		},
+	}
+	unvalidatedJSONBlock := func(customType basetypes.ListTypable) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: customType,
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"unvalidated_json": schema.StringAttribute{
+						CustomType: jsontypes.NormalizedType{},
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"config_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigCapabilityType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"config_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"antenna_downlink_config": configDataMemberBlock(schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"spectrum_config": spectrumConfigBlock,
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[antennaDownlinkConfigModel](ctx), listvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("antenna_downlink_config"),
+							path.MatchRelative().AtParent().AtName("antenna_downlink_demod_decode_config"),
+							path.MatchRelative().AtParent().AtName("antenna_uplink_config"),
+							path.MatchRelative().AtParent().AtName("dataflow_endpoint_config"),
+							path.MatchRelative().AtParent().AtName("s3_recording_config"),
+							path.MatchRelative().AtParent().AtName("tracking_config"),
+							path.MatchRelative().AtParent().AtName("uplink_echo_config"),
+						)),
+						"antenna_downlink_demod_decode_config": configDataMemberBlock(schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"decode_config":       unvalidatedJSONBlock(fwtypes.NewListNestedObjectTypeOf[decodeConfigModel](ctx)),
+								"demodulation_config": unvalidatedJSONBlock(fwtypes.NewListNestedObjectTypeOf[demodulationConfigModel](ctx)),
+								"spectrum_config":     spectrumConfigBlock,
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[antennaDownlinkDemodDecodeConfigModel](ctx)),
+						"antenna_uplink_config": configDataMemberBlock(schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"transmit_disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"spectrum_config": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[uplinkSpectrumConfigModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"polarization": schema.StringAttribute{
+												CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+												Optional:   true,
+												Computed:   true,
+												PlanModifiers: []planmodifier.String{
+													stringplanmodifier.UseStateForUnknown(),
+												},
+											},
+										},
+										Blocks: map[string]schema.Block{
+											"center_frequency": frequencyBlock,
+										},
+									},
+								},
+								"target_eirp": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[eirpModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrUnit: schema.StringAttribute{
+												CustomType: fwtypes.StringEnumType[awstypes.EirpUnits](),
+												Required:   true,
+											},
+											names.AttrValue: schema.Float64Attribute{
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[antennaUplinkConfigModel](ctx)),
+						"dataflow_endpoint_config": configDataMemberBlock(schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"dataflow_endpoint_name": schema.StringAttribute{
+									Required: true,
+								},
+								"dataflow_endpoint_region": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+								},
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[dataflowEndpointConfigModel](ctx)),
+						"s3_recording_config": configDataMemberBlock(schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"bucket_arn": schema.StringAttribute{
+									CustomType: fwtypes.ARNType,
+									Required:   true,
+								},
+								names.AttrPrefix: schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 900),
+									},
+								},
+								names.AttrRoleARN: schema.StringAttribute{
+									CustomType: fwtypes.ARNType,
+									Required:   true,
+								},
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[s3RecordingConfigModel](ctx)),
+						"tracking_config": configDataMemberBlock(schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"autotrack": schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.Criticality](),
+									Required:   true,
+								},
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[trackingConfigModel](ctx)),
+						"uplink_echo_config": configDataMemberBlock(schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"antenna_uplink_config_arn": schema.StringAttribute{
+									CustomType: fwtypes.ARNType,
+									Required:   true,
+								},
+								names.AttrEnabled: schema.BoolAttribute{
+									Required: true,
+								},
+							},
+						}, fwtypes.NewListNestedObjectTypeOf[uplinkEchoConfigModel](ctx)),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *configResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input groundstation.CreateConfigInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConfig(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Config (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Config"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back to pick up server-side defaults.
+	config, err := findConfigByTwoPartKey(ctx, conn, data.ID.ValueString(), data.ConfigType.ValueEnum())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, config, &data, fwflex.WithFieldNamePrefix("Config"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findConfigByTwoPartKey(ctx, conn, id, data.ConfigType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Config"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := fwflex.StringValueFromFramework(ctx, new.ID)
+		var input groundstation.UpdateConfigInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Config"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateConfig(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Config (%s)", id), err.Error())
+
+			return
+		}
+
+		output, err := findConfigByTwoPartKey(ctx, conn, id, new.ConfigType.ValueEnum())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, fwflex.WithFieldNamePrefix("Config"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	tflog.Debug(ctx, "deleting Ground Station Config", map[string]any{
+		names.AttrID:  id,
+		"config_type": data.ConfigType.ValueString(),
+	})
+
+	input := groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(id),
+		ConfigType: data.ConfigType.ValueEnum(),
+	}
+	_, err := conn.DeleteConfig(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Config (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func findConfigByTwoPartKey(ctx context.Context, conn *groundstation.Client, id string, configType awstypes.ConfigCapabilityType) (*groundstation.GetConfigOutput, error) {
+	input := groundstation.GetConfigInput{
+		ConfigId:   aws.String(id),
+		ConfigType: configType,
+	}
+	output, err := conn.GetConfig(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigData == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+type configResourceModel struct {
+	framework.WithRegionModel
+	ARN        types.String                                      `tfsdk:"arn"`
+	ConfigData fwtypes.ListNestedObjectValueOf[configDataModel]  `tfsdk:"config_data"`
+	ConfigType fwtypes.StringEnum[awstypes.ConfigCapabilityType] `tfsdk:"config_type"`
+	ID         types.String                                      `tfsdk:"id"`
+	Name       types.String                                      `tfsdk:"name"`
+	Tags       tftags.Map                                        `tfsdk:"tags"`
+	TagsAll    tftags.Map                                        `tfsdk:"tags_all"`
+}
+
+type configDataModel struct {
+	AntennaDownlinkConfig            fwtypes.ListNestedObjectValueOf[antennaDownlinkConfigModel]            `tfsdk:"antenna_downlink_config"`
+	AntennaDownlinkDemodDecodeConfig fwtypes.ListNestedObjectValueOf[antennaDownlinkDemodDecodeConfigModel] `tfsdk:"antenna_downlink_demod_decode_config"`
+	AntennaUplinkConfig              fwtypes.ListNestedObjectValueOf[antennaUplinkConfigModel]              `tfsdk:"antenna_uplink_config"`
+	DataflowEndpointConfig           fwtypes.ListNestedObjectValueOf[dataflowEndpointConfigModel]           `tfsdk:"dataflow_endpoint_config"`
+	S3RecordingConfig                fwtypes.ListNestedObjectValueOf[s3RecordingConfigModel]                `tfsdk:"s3_recording_config"`
+	TrackingConfig                   fwtypes.ListNestedObjectValueOf[trackingConfigModel]                   `tfsdk:"tracking_config"`
+	UplinkEchoConfig                 fwtypes.ListNestedObjectValueOf[uplinkEchoConfigModel]                 `tfsdk:"uplink_echo_config"`
+}
+
+var (
+	_ fwflex.Expander  = configDataModel{}
+	_ fwflex.Flattener = &configDataModel{}
+)
+
+func (m configDataModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.AntennaDownlinkConfig.IsNull():
+		antennaDownlinkConfigData, d := m.AntennaDownlinkConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberAntennaDownlinkConfig
+		diags.Append(fwflex.Expand(ctx, antennaDownlinkConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.AntennaDownlinkDemodDecodeConfig.IsNull():
+		antennaDownlinkDemodDecodeConfigData, d := m.AntennaDownlinkDemodDecodeConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig
+		diags.Append(fwflex.Expand(ctx, antennaDownlinkDemodDecodeConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.AntennaUplinkConfig.IsNull():
+		antennaUplinkConfigData, d := m.AntennaUplinkConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberAntennaUplinkConfig
+		diags.Append(fwflex.Expand(ctx, antennaUplinkConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.DataflowEndpointConfig.IsNull():
+		dataflowEndpointConfigData, d := m.DataflowEndpointConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberDataflowEndpointConfig
+		diags.Append(fwflex.Expand(ctx, dataflowEndpointConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.S3RecordingConfig.IsNull():
+		s3RecordingConfigData, d := m.S3RecordingConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberS3RecordingConfig
+		diags.Append(fwflex.Expand(ctx, s3RecordingConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.TrackingConfig.IsNull():
+		trackingConfigData, d := m.TrackingConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberTrackingConfig
+		diags.Append(fwflex.Expand(ctx, trackingConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.UplinkEchoConfig.IsNull():
+		uplinkEchoConfigData, d := m.UplinkEchoConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfigTypeDataMemberUplinkEchoConfig
+		diags.Append(fwflex.Expand(ctx, uplinkEchoConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *configDataModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ConfigTypeDataMemberAntennaDownlinkConfig:
+		var model antennaDownlinkConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.AntennaDownlinkConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig:
+		var model antennaDownlinkDemodDecodeConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.AntennaDownlinkDemodDecodeConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ConfigTypeDataMemberAntennaUplinkConfig:
+		var model antennaUplinkConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.AntennaUplinkConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ConfigTypeDataMemberDataflowEndpointConfig:
+		var model dataflowEndpointConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.DataflowEndpointConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ConfigTypeDataMemberS3RecordingConfig:
+		var model s3RecordingConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3RecordingConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ConfigTypeDataMemberTrackingConfig:
+		var model trackingConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TrackingConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ConfigTypeDataMemberUplinkEchoConfig:
+		var model uplinkEchoConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.UplinkEchoConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type antennaDownlinkConfigModel struct {
+	SpectrumConfig fwtypes.ListNestedObjectValueOf[spectrumConfigModel] `tfsdk:"spectrum_config"`
+}
+
+type antennaDownlinkDemodDecodeConfigModel struct {
+	DecodeConfig       fwtypes.ListNestedObjectValueOf[decodeConfigModel]       `tfsdk:"decode_config"`
+	DemodulationConfig fwtypes.ListNestedObjectValueOf[demodulationConfigModel] `tfsdk:"demodulation_config"`
+	SpectrumConfig     fwtypes.ListNestedObjectValueOf[spectrumConfigModel]     `tfsdk:"spectrum_config"`
+}
+
+type antennaUplinkConfigModel struct {
+	SpectrumConfig   fwtypes.ListNestedObjectValueOf[uplinkSpectrumConfigModel] `tfsdk:"spectrum_config"`
+	TargetEIRP       fwtypes.ListNestedObjectValueOf[eirpModel]                 `tfsdk:"target_eirp"`
+	TransmitDisabled types.Bool                                                 `tfsdk:"transmit_disabled"`
+}
+
+type dataflowEndpointConfigModel struct {
+	DataflowEndpointName   types.String `tfsdk:"dataflow_endpoint_name"`
+	DataflowEndpointRegion types.String `tfsdk:"dataflow_endpoint_region"`
+}
+
+type s3RecordingConfigModel struct {
+	BucketARN fwtypes.ARN  `tfsdk:"bucket_arn"`
+	Prefix    types.String `tfsdk:"prefix"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type trackingConfigModel struct {
+	Autotrack fwtypes.StringEnum[awstypes.Criticality] `tfsdk:"autotrack"`
+}
+
+type uplinkEchoConfigModel struct {
+	AntennaUplinkConfigARN fwtypes.ARN `tfsdk:"antenna_uplink_config_arn"`
+	Enabled                types.Bool  `tfsdk:"enabled"`
+}
+
+type spectrumConfigModel struct {
+	Bandwidth       fwtypes.ListNestedObjectValueOf[frequencyBandwidthModel] `tfsdk:"bandwidth"`
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel]          `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]                `tfsdk:"polarization"`
+}
+
+type uplinkSpectrumConfigModel struct {
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel] `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]       `tfsdk:"polarization"`
+}
+
+type frequencyModel struct {
+	Units fwtypes.StringEnum[awstypes.FrequencyUnits] `tfsdk:"unit"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type frequencyBandwidthModel struct {
+	Units fwtypes.StringEnum[awstypes.BandwidthUnits] `tfsdk:"unit"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type eirpModel struct {
+	Units fwtypes.StringEnum[awstypes.EirpUnits] `tfsdk:"unit"`
+	Value types.Float64                          `tfsdk:"value"`
+}
+
+type decodeConfigModel struct {
+	UnvalidatedJSON jsontypes.Normalized `tfsdk:"unvalidated_json"`
+}
+
+type demodulationConfigModel struct {
+	UnvalidatedJSON jsontypes.Normalized `tfsdk:"unvalidated_json"`
+}
+
+var (
+	_ inttypes.ImportIDParser = configImportID{}
+)
+
+type configImportID struct{}
+
+func (configImportID) Parse(id string) (string, map[string]string, error) {
+	configID, configType, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <config-id>"+intflex.ResourceIdSeparator+"<config-type>", id)
+	}
+
+	result := map[string]string{
+		names.AttrID:  configID,
+		"config_type": configType,
+	}
+
+	return id, result, nil
+}
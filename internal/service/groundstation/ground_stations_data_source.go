@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_groundstation_ground_stations", name="Ground Stations")
+func newGroundStationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &groundStationsDataSource{}, nil
+}
+
+type groundStationsDataSource struct {
+	framework.DataSourceWithModel[groundStationsDataSourceModel]
+}
+
+func (d *groundStationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ground_stations": framework.DataSourceComputedListOfObjectAttribute[groundStationModel](ctx),
+			"satellite_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *groundStationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data groundStationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GroundStationClient(ctx)
+
+	var input groundstation.ListGroundStationsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findGroundStations(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Ground Station ground stations", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.GroundStations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findGroundStations(ctx context.Context, conn *groundstation.Client, input *groundstation.ListGroundStationsInput) ([]awstypes.GroundStationData, error) {
+	var output []awstypes.GroundStationData
+
+	pages := groundstation.NewListGroundStationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.GroundStationList...)
+	}
+
+	return output, nil
+}
+
+type groundStationsDataSourceModel struct {
+	framework.WithRegionModel
+	GroundStations fwtypes.ListNestedObjectValueOf[groundStationModel] `tfsdk:"ground_stations"`
+	SatelliteID    types.String                                        `tfsdk:"satellite_id"`
+}
+
+type groundStationModel struct {
+	GroundStationID   types.String `tfsdk:"ground_station_id"`
+	GroundStationName types.String `tfsdk:"ground_station_name"`
+	Region            types.String `tfsdk:"region"`
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationGroundStationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_groundstation_ground_stations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GroundStation)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroundStationsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ground_stations.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ground_stations.0.ground_station_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ground_stations.0.ground_station_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ground_stations.0.region"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroundStationsDataSourceConfig_basic() string {
+	return `
+data "aws_groundstation_ground_stations" "test" {}
+`
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_groundstation_config", sweepConfigs, "aws_groundstation_mission_profile")
+	awsv2.Register("aws_groundstation_dataflow_endpoint_group", sweepDataflowEndpointGroups, "aws_groundstation_config", "aws_groundstation_mission_profile")
+	awsv2.Register("aws_groundstation_mission_profile", sweepMissionProfiles)
+}
+
+func sweepConfigs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GroundStationClient(ctx)
+	var input groundstation.ListConfigsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := groundstation.NewListConfigsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ConfigList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newConfigResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ConfigId)),
+				framework.NewAttribute("config_type", string(v.ConfigType))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDataflowEndpointGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GroundStationClient(ctx)
+	var input groundstation.ListDataflowEndpointGroupsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := groundstation.NewListDataflowEndpointGroupsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DataflowEndpointGroupList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDataflowEndpointGroupResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.DataflowEndpointGroupId))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepMissionProfiles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GroundStationClient(ctx)
+	var input groundstation.ListMissionProfilesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := groundstation.NewListMissionProfilesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MissionProfileList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMissionProfileResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.MissionProfileId))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
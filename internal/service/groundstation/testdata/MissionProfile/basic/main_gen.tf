@@ -0,0 +1,61 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = var.rName
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.dataflow_endpoint.arn
+  }
+}
+
+resource "aws_groundstation_config" "tracking" {
+  name = "${var.rName}-tracking"
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+}
+
+resource "aws_groundstation_config" "downlink" {
+  name = "${var.rName}-downlink"
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        bandwidth {
+          unit  = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          unit  = "MHz"
+          value = 7812
+        }
+
+        polarization = "RIGHT_HAND"
+      }
+    }
+  }
+}
+
+resource "aws_groundstation_config" "dataflow_endpoint" {
+  name = "${var.rName}-dataflow-endpoint"
+
+  config_data {
+    dataflow_endpoint_config {
+      dataflow_endpoint_name = var.rName
+    }
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
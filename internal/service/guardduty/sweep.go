@@ -4,32 +4,32 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_guardduty_detector", &awsv2.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_guardduty_publishing_destination", &awsv2.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
 }
 
-func sweepDetectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepDetectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -59,6 +59,10 @@ func sweepDetectors(region string) error {
 				DetectorId: &detectorID,
 			}
 
+			if sweep.SkipResourceAction(ctx, sweep.Metadata{ID: detectorID}, "delete GuardDuty Detector %s", detectorID) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting GuardDuty Detector: %s", detectorID)
 			_, err := conn.DeleteDetector(ctx, input)
 			if tfawserr.ErrCodeContains(err, "AccessDenied") {
@@ -76,8 +80,7 @@ func sweepDetectors(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPublishingDestinations(region string) error {
-	ctx := sweep.Context(region)
+func sweepPublishingDestinations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -125,6 +128,10 @@ func sweepPublishingDestinations(region string) error {
 						DetectorId:    &detectorID,
 					}
 
+					if sweep.SkipResourceAction(ctx, sweep.Metadata{ID: aws.ToString(destination_element.DestinationId)}, "delete GuardDuty Publishing Destination %s", aws.ToString(destination_element.DestinationId)) {
+						continue
+					}
+
 					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
 					_, err := conn.DeletePublishingDestination(ctx, input)
 
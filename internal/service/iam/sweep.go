@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -28,7 +27,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_group", &awsv2.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +41,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_policy", &awsv2.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +53,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_role", &awsv2.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_auditmanager_assessment",
@@ -92,14 +91,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_server_certificate", &awsv2.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_user", &awsv2.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -112,8 +111,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -143,6 +141,15 @@ func sweepGroups(region string) error {
 				continue
 			}
 
+			m := sweep.Metadata{
+				ID:        aws.ToString(group.GroupId),
+				Name:      name,
+				CreatedAt: aws.ToTime(group.CreateDate),
+			}
+			if sweep.SkipResourceAction(ctx, m, "delete IAM Group %s, removing its users, policies and policy attachments", name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting IAM Group: %s", name)
 
 			getGroupInput := &iam.GetGroupInput{
@@ -346,8 +353,7 @@ func sweepServiceSpecificCredentials(ctx context.Context, client *conns.AWSClien
 	return sweepResources, nil
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -420,15 +426,14 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 	return nil
 }
 
-func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.IAMClient(ctx)
 
-	roles := make([]string, 0)
+	roles := make([]awstypes.Role, 0)
 	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -444,7 +449,7 @@ func sweepRoles(region string) error {
 		for _, role := range page.Roles {
 			roleName := aws.ToString(role.RoleName)
 			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
+				roles = append(roles, role)
 			} else {
 				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
 			}
@@ -458,7 +463,17 @@ func sweepRoles(region string) error {
 
 	var sweeperErrs *multierror.Error
 
-	for _, roleName := range roles {
+	for _, role := range roles {
+		roleName := aws.ToString(role.RoleName)
+		m := sweep.Metadata{
+			ID:        aws.ToString(role.RoleId),
+			Name:      roleName,
+			CreatedAt: aws.ToTime(role.CreateDate),
+		}
+		if sweep.SkipResourceAction(ctx, m, "delete IAM Role %s, detaching its policies and instance profiles", roleName) {
+			continue
+		}
+
 		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
 
 		err := deleteRole(ctx, conn, roleName, true, true, true)
@@ -501,8 +516,7 @@ func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 	return sweepResources, err
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepServerCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -522,6 +536,15 @@ func sweepServerCertificates(region string) error {
 		}
 
 		for _, sc := range page.ServerCertificateMetadataList {
+			m := sweep.Metadata{
+				ID:        aws.ToString(sc.ServerCertificateId),
+				Name:      aws.ToString(sc.ServerCertificateName),
+				CreatedAt: aws.ToTime(sc.UploadDate),
+			}
+			if sweep.SkipResourceAction(ctx, m, "delete IAM Server Certificate %s", aws.ToString(sc.ServerCertificateName)) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
 
 			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
@@ -580,8 +603,7 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 	return sweepResources, nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
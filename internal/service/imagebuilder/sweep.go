@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -22,37 +21,37 @@ import (
 func RegisterSweepers() {
 	awsv2.Register("aws_imagebuilder_component", sweepComponents)
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_distribution_configuration", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_image_pipeline", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_image_recipe", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_container_recipe", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_container_recipe",
 		F:    sweepContainerRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_image", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &awsv2.Sweeper{
 		Name: "aws_imagebuilder_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
@@ -99,8 +98,7 @@ func sweepComponents(ctx context.Context, client *conns.AWSClient) ([]sweep.Swee
 	return sweepResources, nil
 }
 
-func sweepDistributionConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDistributionConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -140,8 +138,7 @@ func sweepDistributionConfigurations(region string) error {
 	return nil
 }
 
-func sweepImagePipelines(region string) error {
-	ctx := sweep.Context(region)
+func sweepImagePipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -181,8 +178,7 @@ func sweepImagePipelines(region string) error {
 	return nil
 }
 
-func sweepImageRecipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepImageRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -222,8 +218,7 @@ func sweepImageRecipes(region string) error {
 	return nil
 }
 
-func sweepContainerRecipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepContainerRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -263,8 +258,7 @@ func sweepContainerRecipes(region string) error {
 	return nil
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+func sweepImages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -304,8 +298,7 @@ func sweepImages(region string) error {
 	return nil
 }
 
-func sweepInfrastructureConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepInfrastructureConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -345,8 +338,7 @@ func sweepInfrastructureConfigurations(region string) error {
 	return nil
 }
 
-func sweepLifecyclePolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepLifecyclePolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
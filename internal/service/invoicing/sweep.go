@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/invoicing"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_invoicing_invoice_unit", sweepInvoiceUnits)
+}
+
+func sweepInvoiceUnits(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.InvoicingClient(ctx)
+	var input invoicing.ListInvoiceUnitsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := listInvoiceUnitsPages(ctx, conn, &input, func(page *invoicing.ListInvoiceUnitsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InvoiceUnits {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newInvoiceUnitResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.InvoiceUnitArn)),
+			))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
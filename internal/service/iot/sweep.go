@@ -4,6 +4,7 @@
 package iot
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,14 +14,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_certificate", &awsv2.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -29,12 +29,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_policy_attachment", &awsv2.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_policy", &awsv2.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -42,17 +42,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_role_alias", &awsv2.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_thing_principal_attachment", &awsv2.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_thing", &awsv2.Sweeper{
 		Name: "aws_iot_thing",
 		F:    sweepThings,
 		Dependencies: []string{
@@ -60,12 +60,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_thing_group", &awsv2.Sweeper{
 		Name: "aws_iot_thing_group",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_thing_type", &awsv2.Sweeper{
 		Name: "aws_iot_thing_type",
 		F:    sweepThingTypes,
 		Dependencies: []string{
@@ -73,7 +73,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_topic_rule", &awsv2.Sweeper{
 		Name: "aws_iot_topic_rule",
 		F:    sweepTopicRules,
 		Dependencies: []string{
@@ -81,12 +81,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_topic_rule_destination", &awsv2.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
 
-	resource.AddTestSweepers("aws_iot_authorizer", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_authorizer", &awsv2.Sweeper{
 		Name: "aws_iot_authorizer",
 		F:    sweepAuthorizers,
 		Dependencies: []string{
@@ -94,12 +94,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_domain_configuration", &awsv2.Sweeper{
 		Name: "aws_iot_domain_configuration",
 		F:    sweepDomainConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iot_ca_certificate", &awsv2.Sweeper{
 		Name: "aws_iot_ca_certificate",
 		F:    sweepCACertificates,
 		Dependencies: []string{
@@ -108,8 +108,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -150,8 +149,7 @@ func sweepCertificates(region string) error {
 	return nil
 }
 
-func sweepPolicyAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicyAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -214,8 +212,7 @@ func sweepPolicyAttachments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -255,8 +252,7 @@ func sweepPolicies(region string) error {
 	return nil
 }
 
-func sweepRoleAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoleAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -296,8 +292,7 @@ func sweepRoleAliases(region string) error {
 	return nil
 }
 
-func sweepThingPrincipalAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingPrincipalAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -360,8 +355,7 @@ func sweepThingPrincipalAttachments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepThings(region string) error {
-	ctx := sweep.Context(region)
+func sweepThings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -401,8 +395,7 @@ func sweepThings(region string) error {
 	return nil
 }
 
-func sweepThingTypes(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingTypes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -442,8 +435,7 @@ func sweepThingTypes(region string) error {
 	return nil
 }
 
-func sweepTopicRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -483,8 +475,7 @@ func sweepTopicRules(region string) error {
 	return nil
 }
 
-func sweepThingGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -524,8 +515,7 @@ func sweepThingGroups(region string) error {
 	return nil
 }
 
-func sweepTopicRuleDestinations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicRuleDestinations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -565,8 +555,7 @@ func sweepTopicRuleDestinations(region string) error {
 	return nil
 }
 
-func sweepAuthorizers(region string) error {
-	ctx := sweep.Context(region)
+func sweepAuthorizers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -607,8 +596,7 @@ func sweepAuthorizers(region string) error {
 	return nil
 }
 
-func sweepDomainConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -671,8 +659,7 @@ func sweepDomainConfigurations(region string) error {
 	return nil
 }
 
-func sweepCACertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCACertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -5,9 +5,7 @@ package lambda
 
 import (
 	"context"
-	"errors"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -99,20 +97,9 @@ func (e *invocationEphemeralResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
-	output, err := conn.Invoke(ctx, input)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.Lambda, create.ErrActionOpening, ResNameInvocation, data.FunctionName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	if output.FunctionError != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.Lambda, create.ErrActionOpening, ResNameInvocation, data.FunctionName.String(), errors.New(aws.ToString(output.FunctionError))),
-			"",
-		)
+	output, diags := invokeFunction(ctx, conn, input, create.ErrActionOpening, ResNameInvocation, data.FunctionName.String())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
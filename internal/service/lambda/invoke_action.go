@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_lambda_invoke", name="Invoke")
+func newInvokeAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &invokeAction{}, nil
+}
+
+const (
+	ResNameInvoke = "Invoke"
+)
+
+type invokeAction struct {
+	framework.ActionWithModel[invokeActionModel]
+}
+
+func (a *invokeAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Invokes an AWS Lambda function.",
+		Attributes: map[string]schema.Attribute{
+			"client_context": schema.StringAttribute{
+				Optional:    true,
+				Description: "Up to 3,583 bytes of base64-encoded data about the invoking client to pass to the function in the context object.",
+			},
+			"function_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name, ARN or partial ARN of the Lambda function to invoke.",
+			},
+			"invocation_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.InvocationType](),
+				Optional:    true,
+				Description: "Invocation type. Defaults to `RequestResponse`.",
+			},
+			"log_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LogType](),
+				Optional:    true,
+				Description: "Set to `Tail` to include the execution log in the action's progress output. Only applies to synchronous invocations.",
+			},
+			"payload": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON that is provided to the Lambda function as input.",
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version or alias of the Lambda function to invoke.",
+			},
+		},
+	}
+}
+
+func (a *invokeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var config invokeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+
+	functionName := fwflex.StringValueFromFramework(ctx, config.FunctionName)
+	input := lambda.InvokeInput{
+		InvocationType: awstypes.InvocationTypeRequestResponse,
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Invoking Lambda Function", map[string]any{
+		"function_name":   functionName,
+		"invocation_type": input.InvocationType,
+	})
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Invoking Lambda Function %s...", functionName),
+	})
+
+	output, diags := invokeFunction(ctx, conn, &input, create.ErrActionInvoking, ResNameInvoke, functionName)
+
+	if output != nil {
+		sendInvokeLogProgress(response, output)
+	}
+
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Lambda Function %s invoked (status code %d, executed version %s)", functionName, output.StatusCode, aws.ToString(output.ExecutedVersion)),
+	})
+}
+
+// invokeFunction invokes a Lambda function.
+// If the function itself returns an error, an error diagnostic is returned along with the invocation's output.
+func invokeFunction(ctx context.Context, conn *lambda.Client, input *lambda.InvokeInput, errAction, resName, id string) (*lambda.InvokeOutput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	output, err := conn.Invoke(ctx, input)
+
+	if err != nil {
+		diags.AddError(create.ProblemStandardMessage(names.Lambda, errAction, resName, id, err), err.Error())
+		return nil, diags
+	}
+
+	if output.FunctionError != nil {
+		diags.AddError(create.ProblemStandardMessage(names.Lambda, errAction, resName, id, errors.New(aws.ToString(output.FunctionError))), "")
+		return output, diags
+	}
+
+	return output, diags
+}
+
+func sendInvokeLogProgress(response *action.InvokeResponse, output *lambda.InvokeOutput) {
+	if output.LogResult == nil {
+		return
+	}
+
+	v, err := base64.StdEncoding.DecodeString(aws.ToString(output.LogResult))
+	if err != nil {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: string(v),
+	})
+}
+
+type invokeActionModel struct {
+	framework.WithRegionModel
+	ClientContext  types.String                                `tfsdk:"client_context"`
+	FunctionName   types.String                                `tfsdk:"function_name"`
+	InvocationType fwtypes.StringEnum[awstypes.InvocationType] `tfsdk:"invocation_type"`
+	LogType        fwtypes.StringEnum[awstypes.LogType]        `tfsdk:"log_type"`
+	Payload        types.String                                `tfsdk:"payload"`
+	Qualifier      types.String                                `tfsdk:"qualifier"`
+}
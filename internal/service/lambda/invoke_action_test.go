@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaInvokeAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ssmParameterName := fmt.Sprintf("/tf-test/action/%s", rName)
+	// The test function records its input in an SSM parameter when invoked with a "delete" action.
+	payloadJSON := `{"key1":"value1","key2":"value2","tf":{"action":"delete"}}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function("lambda_invocation_crud", rName, ssmParameterName),
+					testAccInvocationConfig_crudAllowSSM(rName, ssmParameterName),
+					testAccInvokeActionConfig_basic(payloadJSON),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvokeActionResult(ctx, ssmParameterName, payloadJSON),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInvokeActionResult(ctx context.Context, ssmParameterName, expectedResult string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+		res, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+			Name: aws.String(ssmParameterName),
+		})
+
+		if cleanupErr := removeSSMParameter(ctx, conn, ssmParameterName); cleanupErr != nil && err == nil {
+			return fmt.Errorf("Could not cleanup SSM Parameter %s", ssmParameterName)
+		}
+
+		if err != nil {
+			return fmt.Errorf("Lambda function not invoked, could not get SSM Parameter %s: %w", ssmParameterName, err)
+		}
+
+		if !verify.JSONStringsEqual(aws.ToString(res.Parameter.Value), expectedResult) {
+			return fmt.Errorf("Lambda function invoked with %s, expected %s", aws.ToString(res.Parameter.Value), expectedResult)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvokeActionConfig_basic(payloadJSON string) string {
+	return fmt.Sprintf(`
+action "aws_lambda_invoke" "test" {
+  config {
+    function_name = aws_lambda_function.test.function_name
+    log_type      = "Tail"
+    payload       = %[1]s
+  }
+}
+
+resource "terraform_data" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test_ssm]
+
+  input = aws_lambda_function.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_invoke.test]
+    }
+  }
+}
+`, strconv.Quote(payloadJSON))
+}
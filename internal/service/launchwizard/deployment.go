@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_launchwizard_deployment", name="Deployment")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/launchwizard/types;types.DeploymentData")
+// @Testing(tagsTest=false, identityTest=false)
+// Testing requires workload-specific prerequisites (VPC, key pair, license media) and takes hours.
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_pattern_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"specifications": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"specifications_wo": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"specifications_wo_version"},
+			},
+			"specifications_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"specifications_wo"},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workload_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := launchwizard.CreateDeploymentInput{
+		DeploymentPatternName: aws.String(d.Get("deployment_pattern_name").(string)),
+		Name:                  aws.String(name),
+		Specifications:        make(map[string]string),
+		Tags:                  getTagsIn(ctx),
+		WorkloadName:          aws.String(d.Get("workload_name").(string)),
+	}
+
+	if v, ok := d.GetOk("specifications"); ok && len(v.(map[string]any)) > 0 {
+		input.Specifications = flex.ExpandStringValueMap(v.(map[string]any))
+	}
+
+	specificationsWO, di := flex.GetWriteOnlyValue(d, cty.GetAttrPath("specifications_wo"), cty.Map(cty.String))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Write-only values take precedence over any plain-text value with the same key.
+	if !specificationsWO.IsNull() && specificationsWO.IsKnown() {
+		for k, v := range specificationsWO.AsValueMap() {
+			if !v.IsNull() {
+				input.Specifications[k] = v.AsString()
+			}
+		}
+	}
+
+	output, err := conn.CreateDeployment(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Launch Wizard Deployment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DeploymentId))
+
+	if _, err := waitDeploymentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Launch Wizard Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	deployment, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Launch Wizard Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Launch Wizard Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, deployment.DeploymentArn)
+	d.Set(names.AttrCreatedAt, aws.ToTime(deployment.CreatedAt).Format(time.RFC3339))
+	d.Set("deployment_pattern_name", deployment.PatternName)
+	d.Set(names.AttrName, deployment.Name)
+	d.Set("resource_group", deployment.ResourceGroup)
+	if err := d.Set("specifications", flattenDeploymentSpecifications(d, deployment.Specifications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting specifications: %s", err)
+	}
+	d.Set(names.AttrStatus, deployment.Status)
+	d.Set("workload_name", deployment.WorkloadName)
+
+	setTagsOut(ctx, deployment.Tags)
+
+	return diags
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Launch Wizard Deployment: %s", d.Id())
+	input := launchwizard.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	}
+	_, err := conn.DeleteDeployment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Launch Wizard Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Launch Wizard Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// flattenDeploymentSpecifications returns only those specifications that are managed in plain text.
+// Values supplied via the write-only attribute are never persisted to state.
+// On import it is not known which specifications are sensitive, so none are returned.
+func flattenDeploymentSpecifications(d *schema.ResourceData, apiObject map[string]string) map[string]string {
+	configured, ok := d.GetOk("specifications")
+	if !ok {
+		return nil
+	}
+
+	tfMap := make(map[string]string)
+	for k := range configured.(map[string]any) {
+		if v, ok := apiObject[k]; ok {
+			tfMap[k] = v
+		}
+	}
+
+	return tfMap
+}
+
+func findDeploymentByID(ctx context.Context, conn *launchwizard.Client, id string) (*awstypes.DeploymentData, error) {
+	input := launchwizard.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+	output, err := findDeployment(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.DeploymentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findDeployment(ctx context.Context, conn *launchwizard.Client, input *launchwizard.GetDeploymentInput) (*awstypes.DeploymentData, error) {
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Deployment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Deployment, nil
+}
+
+func statusDeployment(ctx context.Context, conn *launchwizard.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDeploymentCreated(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.DeploymentStatusCreating, awstypes.DeploymentStatusInProgress, awstypes.DeploymentStatusValidating),
+		Target:       enum.Slice(awstypes.DeploymentStatusCompleted),
+		Refresh:      statusDeployment(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentDeleted(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.DeploymentStatusCompleted, awstypes.DeploymentStatusFailed, awstypes.DeploymentStatusDeleteInitiating, awstypes.DeploymentStatusDeleteInProgress),
+		Target:       []string{},
+		Refresh:      statusDeployment(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	tfcversion "github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflaunchwizard "github.com/hashicorp/terraform-provider-aws/internal/service/launchwizard"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// JSON object of plain-text specifications for an SAP HANA single-node deployment.
+	envVarDeploymentSpecifications = "LAUNCHWIZARD_DEPLOYMENT_SPECIFICATIONS"
+	// Password supplied to the deployment via the write-only attribute.
+	envVarDeploymentPassword = "LAUNCHWIZARD_DEPLOYMENT_PASSWORD"
+)
+
+func TestAccLaunchWizardDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentSpecifications)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LaunchWizard) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, specifications),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "launchwizard", regexache.MustCompile(`deployment/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_pattern_name", "SapHanaSingle"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_group"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DeploymentStatusCompleted)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", "SAP"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specifications"},
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentSpecifications)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LaunchWizard) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, specifications),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflaunchwizard.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentSpecifications)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LaunchWizard) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_tags1(rName, specifications, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specifications"},
+			},
+			{
+				Config: testAccDeploymentConfig_tags2(rName, specifications, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags1(rName, specifications, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_specificationsWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentSpecifications)
+	password := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentPassword)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LaunchWizard) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfcversion.Must(tfcversion.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_specificationsWriteOnly(rName, specifications, password, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "specifications_wo"),
+					resource.TestCheckNoResourceAttr(resourceName, "specifications.DatabasePassword"),
+					resource.TestCheckResourceAttr(resourceName, "specifications_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launchwizard_deployment" {
+				continue
+			}
+
+			_, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Launch Wizard Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *awstypes.DeploymentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		output, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_basic(rName, specifications string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = "SAP"
+  deployment_pattern_name = "SapHanaSingle"
+  specifications          = jsondecode(%[2]q)
+}
+`, rName, specifications)
+}
+
+func testAccDeploymentConfig_tags1(rName, specifications, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = "SAP"
+  deployment_pattern_name = "SapHanaSingle"
+  specifications          = jsondecode(%[2]q)
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, specifications, tagKey1, tagValue1)
+}
+
+func testAccDeploymentConfig_tags2(rName, specifications, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = "SAP"
+  deployment_pattern_name = "SapHanaSingle"
+  specifications          = jsondecode(%[2]q)
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, specifications, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccDeploymentConfig_specificationsWriteOnly(rName, specifications, password string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = "SAP"
+  deployment_pattern_name = "SapHanaSingle"
+  specifications          = jsondecode(%[2]q)
+
+  specifications_wo = {
+    DatabasePassword = %[3]q
+  }
+  specifications_wo_version = %[4]d
+}
+`, rName, specifications, password, version)
+}
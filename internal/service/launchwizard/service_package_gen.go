@@ -4,6 +4,7 @@ package launchwizard
 
 import (
 	"context"
+	"unique"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
@@ -18,7 +19,14 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
-	return []*inttypes.ServicePackageFrameworkDataSource{}
+	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newWorkloadDeploymentPatternDataSource,
+			TypeName: "aws_launchwizard_workload_deployment_pattern",
+			Name:     "Workload Deployment Pattern",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
@@ -30,7 +38,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
-	return []*inttypes.ServicePackageSDKResource{}
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourceDeployment,
+			TypeName: "aws_launchwizard_deployment",
+			Name:     "Deployment",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -4,61 +4,60 @@
 package lightsail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_container_service", &awsv2.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_database", &awsv2.Sweeper{
 		Name: "aws_lightsail_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_disk", &awsv2.Sweeper{
 		Name: "aws_lightsail_disk",
 		F:    sweepDisks,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_distribution", &awsv2.Sweeper{
 		Name: "aws_lightsail_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_domain", &awsv2.Sweeper{
 		Name: "aws_lightsail_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_instance", &awsv2.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_lb", &awsv2.Sweeper{
 		Name: "aws_lightsail_lb",
 		F:    sweepLoadBalancers,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lightsail_static_ip", &awsv2.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
 }
 
-func sweepContainerServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepContainerServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -94,8 +93,7 @@ func sweepContainerServices(region string) error {
 	return nil
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -138,8 +136,7 @@ func sweepDatabases(region string) error {
 	return nil
 }
 
-func sweepDisks(region string) error {
-	ctx := sweep.Context(region)
+func sweepDisks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -181,8 +178,7 @@ func sweepDisks(region string) error {
 	return nil
 }
 
-func sweepDistributions(region string) error {
-	ctx := sweep.Context(region)
+func sweepDistributions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -224,8 +220,7 @@ func sweepDistributions(region string) error {
 	return nil
 }
 
-func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -267,8 +262,7 @@ func sweepDomains(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -292,6 +286,16 @@ func sweepInstances(region string) error {
 
 		for _, instance := range output.Instances {
 			name := aws.ToString(instance.Name)
+
+			m := sweep.Metadata{
+				ID:        name,
+				Tags:      keyValueTags(ctx, instance.Tags).Map(),
+				CreatedAt: aws.ToTime(instance.CreatedAt),
+			}
+			if sweep.SkipResourceAction(ctx, m, "delete Lightsail Instance %s", name) {
+				continue
+			}
+
 			input := &lightsail.DeleteInstanceInput{
 				InstanceName: instance.Name,
 			}
@@ -316,8 +320,7 @@ func sweepInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -359,8 +362,7 @@ func sweepLoadBalancers(region string) error {
 	return nil
 }
 
-func sweepStaticIPs(region string) error {
-	ctx := sweep.Context(region)
+func sweepStaticIPs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -387,6 +389,14 @@ func sweepStaticIPs(region string) error {
 		for _, staticIp := range output.StaticIps {
 			name := aws.ToString(staticIp.Name)
 
+			m := sweep.Metadata{
+				ID:        name,
+				CreatedAt: aws.ToTime(staticIp.CreatedAt),
+			}
+			if sweep.SkipResourceAction(ctx, m, "release Lightsail Static IP %s", name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
 			_, err := conn.ReleaseStaticIp(ctx, &lightsail.ReleaseStaticIpInput{
 				StaticIpName: aws.String(name),
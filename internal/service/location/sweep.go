@@ -4,50 +4,49 @@
 package location
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/location"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_geofence_collection", &awsv2.Sweeper{
 		Name: "aws_location_geofence_collection",
 		F:    sweepGeofenceCollections,
 	})
 
-	resource.AddTestSweepers("aws_location_map", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_map", &awsv2.Sweeper{
 		Name: "aws_location_map",
 		F:    sweepMaps,
 	})
 
-	resource.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_place_index", &awsv2.Sweeper{
 		Name: "aws_location_place_index",
 		F:    sweepPlaceIndexes,
 	})
 
-	resource.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_route_calculator", &awsv2.Sweeper{
 		Name: "aws_location_route_calculator",
 		F:    sweepRouteCalculators,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_tracker", &awsv2.Sweeper{
 		Name: "aws_location_tracker",
 		F:    sweepTrackers,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_tracker_association", &awsv2.Sweeper{
 		Name: "aws_location_tracker_association",
 		F:    sweepTrackerAssociations,
 	})
 }
 
-func sweepGeofenceCollections(region string) error {
-	ctx := sweep.Context(region)
+func sweepGeofenceCollections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -89,8 +88,7 @@ func sweepGeofenceCollections(region string) error {
 	return nil
 }
 
-func sweepMaps(region string) error {
-	ctx := sweep.Context(region)
+func sweepMaps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -133,8 +131,7 @@ func sweepMaps(region string) error {
 	return nil
 }
 
-func sweepPlaceIndexes(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlaceIndexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -177,8 +174,7 @@ func sweepPlaceIndexes(region string) error {
 	return nil
 }
 
-func sweepRouteCalculators(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteCalculators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -221,8 +217,7 @@ func sweepRouteCalculators(region string) error {
 	return nil
 }
 
-func sweepTrackers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrackers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -265,8 +260,7 @@ func sweepTrackers(region string) error {
 	return nil
 }
 
-func sweepTrackerAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrackerAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -0,0 +1,828 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_bridge", name="Bridge")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Bridge")
+// @Testing(importIgnore="timeouts")
+// @Testing(hasNoPreExistingResource=true)
+func newBridgeResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bridgeResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type bridgeResource struct {
+	framework.ResourceWithModel[bridgeResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *bridgeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bridge_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BridgeState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby)...),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_failover_config": framework.ResourceOptionalComputedListOfObjectsAttribute[failoverConfigModel](ctx, 1, nil, listplanmodifier.UseStateForUnknown()),
+		},
+		Blocks: map[string]schema.Block{
+			"egress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ExactlyOneOf(path.MatchRoot("ingress_gateway_bridge")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_bitrate": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_bitrate": schema.Int64Attribute{
+							Required: true,
+						},
+						"max_outputs": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"network_output": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkOutputModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIPAddress: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int64Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+									"ttl": schema.Int64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"flow_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeFlowSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("network_source")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flow_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"flow_vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+								},
+							},
+						},
+						"network_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multicast_ip": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int64Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *bridgeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mediaconnect.CreateBridgeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateBridge(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Bridge (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Bridge.BridgeArn)
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+
+	bridge, err := waitBridgeCreated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if target := data.BridgeState.ValueEnum(); target != "" && !bridgeInState(bridge, target) {
+		if bridge, err = updateBridgeState(ctx, conn, arn, target, timeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) state", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(flattenBridge(ctx, bridge, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bridgeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findBridgeByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenBridge(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bridgeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bridgeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.EgressGatewayBridge.Equal(old.EgressGatewayBridge) || !new.IngressGatewayBridge.Equal(old.IngressGatewayBridge) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		var input mediaconnect.UpdateBridgeInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Bridge"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateBridge(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Add sources before removing any so that the bridge always has at least one.
+	sourcesAdd, sourcesRemove, sourcesUpdate, d := diffFlowSubEntities(ctx, new.Sources, old.Sources, func(v *bridgeSourceModel) string {
+		return v.name(ctx)
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(sourcesAdd) > 0 {
+		input := mediaconnect.AddBridgeSourcesInput{
+			BridgeArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, sourcesAdd), &input.Sources)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.AddBridgeSources(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Bridge (%s) sources", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range sourcesUpdate {
+		name := v[0].name(ctx)
+		var input mediaconnect.UpdateBridgeSourceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[0], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.BridgeArn = aws.String(arn)
+		input.SourceName = aws.String(name)
+
+		_, err := conn.UpdateBridgeSource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) source (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range sourcesRemove {
+		name := v.name(ctx)
+		input := mediaconnect.RemoveBridgeSourceInput{
+			BridgeArn:  aws.String(arn),
+			SourceName: aws.String(name),
+		}
+		_, err := conn.RemoveBridgeSource(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Bridge (%s) source (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	outputsAdd, outputsRemove, outputsUpdate, d := diffFlowSubEntities(ctx, new.Outputs, old.Outputs, func(v *bridgeOutputModel) string {
+		return v.name(ctx)
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(outputsAdd) > 0 {
+		input := mediaconnect.AddBridgeOutputsInput{
+			BridgeArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, outputsAdd), &input.Outputs)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.AddBridgeOutputs(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Bridge (%s) outputs", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range outputsUpdate {
+		name := v[0].name(ctx)
+		var input mediaconnect.UpdateBridgeOutputInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[0], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.BridgeArn = aws.String(arn)
+		input.OutputName = aws.String(name)
+
+		_, err := conn.UpdateBridgeOutput(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) output (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range outputsRemove {
+		name := v.name(ctx)
+		input := mediaconnect.RemoveBridgeOutputInput{
+			BridgeArn:  aws.String(arn),
+			OutputName: aws.String(name),
+		}
+		_, err := conn.RemoveBridgeOutput(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Bridge (%s) output (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	bridge, err := waitBridgeUpdated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) update", arn), err.Error())
+
+		return
+	}
+
+	if target := new.BridgeState.ValueEnum(); !new.BridgeState.Equal(old.BridgeState) && !bridgeInState(bridge, target) {
+		if bridge, err = updateBridgeState(ctx, conn, arn, target, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) state", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(flattenBridge(ctx, bridge, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bridgeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A running bridge must be stopped before it can be deleted.
+	bridge, err := findBridgeByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	if !bridgeInState(bridge, awstypes.BridgeStateStandby) {
+		if _, err := updateBridgeState(ctx, conn, arn, awstypes.BridgeStateStandby, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) state", arn), err.Error())
+
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "deleting MediaConnect Bridge", map[string]any{
+		names.AttrARN: arn,
+	})
+
+	input := mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	_, err = conn.DeleteBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, arn, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+// bridgeInState returns whether the bridge is in, or is settling into, the specified desired state.
+// A started bridge waits in START_PENDING until its gateway instances are available.
+func bridgeInState(bridge *awstypes.Bridge, state awstypes.BridgeState) bool {
+	switch state {
+	case awstypes.BridgeStateActive:
+		return bridge.BridgeState == awstypes.BridgeStateActive || bridge.BridgeState == awstypes.BridgeStateStartPending
+	default:
+		return bridge.BridgeState == state
+	}
+}
+
+func updateBridgeState(ctx context.Context, conn *mediaconnect.Client, arn string, state awstypes.BridgeState, timeout time.Duration) (*awstypes.Bridge, error) {
+	input := mediaconnect.UpdateBridgeStateInput{
+		BridgeArn:    aws.String(arn),
+		DesiredState: awstypes.DesiredState(state),
+	}
+	if _, err := conn.UpdateBridgeState(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	if state == awstypes.BridgeStateActive {
+		return waitBridgeStarted(ctx, conn, arn, timeout)
+	}
+
+	return waitBridgeStopped(ctx, conn, arn, timeout)
+}
+
+func flattenBridge(ctx context.Context, bridge *awstypes.Bridge, data *bridgeResourceModel) diag.Diagnostics {
+	// Flow outputs are attached to the bridge by the flows that send to it.
+	bridge.Outputs = tfslices.Filter(bridge.Outputs, func(v awstypes.BridgeOutput) bool {
+		return v.NetworkOutput != nil
+	})
+
+	sortByPriorOrder(ctx, bridge.Outputs, data.Outputs, func(v awstypes.BridgeOutput) string {
+		return aws.ToString(v.NetworkOutput.Name)
+	}, func(v *bridgeOutputModel) string {
+		return v.name(ctx)
+	})
+	sortByPriorOrder(ctx, bridge.Sources, data.Sources, func(v awstypes.BridgeSource) string {
+		switch {
+		case v.FlowSource != nil:
+			return aws.ToString(v.FlowSource.Name)
+		case v.NetworkSource != nil:
+			return aws.ToString(v.NetworkSource.Name)
+		}
+		return ""
+	}, func(v *bridgeSourceModel) string {
+		return v.name(ctx)
+	})
+
+	prior := data.BridgeState
+	diags := fwflex.Flatten(ctx, bridge, data, fwflex.WithFieldNamePrefix("Bridge"))
+	if diags.HasError() {
+		return diags
+	}
+
+	// Report the desired state while the bridge is settling into it.
+	if !prior.IsNull() && !prior.IsUnknown() && bridgeInState(bridge, prior.ValueEnum()) {
+		data.BridgeState = prior
+	}
+
+	return diags
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	output, err := conn.DescribeBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if state := output.Bridge.BridgeState; state == awstypes.BridgeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateCreating, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting, awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeploying, awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeStarted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateStarting, awstypes.BridgeStateDeploying, awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStartPending, awstypes.BridgeStateStopping, awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateStandby),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeleting, awstypes.BridgeStateStandby),
+		Target:  []string{},
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type bridgeResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                               `tfsdk:"arn"`
+	BridgeState          fwtypes.StringEnum[awstypes.BridgeState]                   `tfsdk:"bridge_state"`
+	EgressGatewayBridge  fwtypes.ListNestedObjectValueOf[egressGatewayBridgeModel]  `tfsdk:"egress_gateway_bridge"`
+	IngressGatewayBridge fwtypes.ListNestedObjectValueOf[ingressGatewayBridgeModel] `tfsdk:"ingress_gateway_bridge"`
+	Name                 types.String                                               `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[bridgeOutputModel]         `tfsdk:"output"`
+	PlacementARN         fwtypes.ARN                                                `tfsdk:"placement_arn"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]       `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[bridgeSourceModel]         `tfsdk:"source"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type egressGatewayBridgeModel struct {
+	MaxBitrate types.Int64 `tfsdk:"max_bitrate"`
+}
+
+type ingressGatewayBridgeModel struct {
+	MaxBitrate types.Int64 `tfsdk:"max_bitrate"`
+	MaxOutputs types.Int64 `tfsdk:"max_outputs"`
+}
+
+type bridgeOutputModel struct {
+	NetworkOutput fwtypes.ListNestedObjectValueOf[bridgeNetworkOutputModel] `tfsdk:"network_output"`
+}
+
+func (m *bridgeOutputModel) name(ctx context.Context) string {
+	if v, diags := m.NetworkOutput.ToPtr(ctx); !diags.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeNetworkOutputModel struct {
+	IPAddress   types.String                          `tfsdk:"ip_address"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int64                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+	TTL         types.Int64                           `tfsdk:"ttl"`
+}
+
+type bridgeSourceModel struct {
+	FlowSource    fwtypes.ListNestedObjectValueOf[bridgeFlowSourceModel]    `tfsdk:"flow_source"`
+	NetworkSource fwtypes.ListNestedObjectValueOf[bridgeNetworkSourceModel] `tfsdk:"network_source"`
+}
+
+func (m *bridgeSourceModel) name(ctx context.Context) string {
+	if v, diags := m.FlowSource.ToPtr(ctx); !diags.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+	if v, diags := m.NetworkSource.ToPtr(ctx); !diags.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeFlowSourceModel struct {
+	FlowARN                    fwtypes.ARN                                                  `tfsdk:"flow_arn"`
+	FlowVPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"flow_vpc_interface_attachment"`
+	Name                       types.String                                                 `tfsdk:"name"`
+}
+
+type bridgeNetworkSourceModel struct {
+	MulticastIP types.String                          `tfsdk:"multicast_ip"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int64                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+}
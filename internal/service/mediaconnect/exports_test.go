@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+// Exports for use in tests only.
+var (
+	ResourceBridge     = newBridgeResource
+	ResourceFlow       = newFlowResource
+	ResourceFlowOutput = newFlowOutputResource
+	ResourceGateway    = newGatewayResource
+
+	FindBridgeByARN            = findBridgeByARN
+	FindFlowByARN              = findFlowByARN
+	FindFlowOutputByTwoPartKey = findFlowOutputByTwoPartKey
+	FindGatewayByARN           = findGatewayByARN
+)
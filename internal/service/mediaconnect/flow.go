@@ -0,0 +1,1527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Flow")
+// @Testing(importIgnore="timeouts")
+// @Testing(hasNoPreExistingResource=true)
+func newFlowResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithModel[flowResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"egress_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow_size": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowSize](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"maintenance": framework.ResourceOptionalComputedListOfObjectsAttribute[flowMaintenanceModel](ctx, 1, nil, listplanmodifier.UseStateForUnknown()),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_failover_config": framework.ResourceOptionalComputedListOfObjectsAttribute[failoverConfigModel](ctx, 1, nil, listplanmodifier.UseStateForUnknown()),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusStandby)...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"entitlement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowEntitlementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						"data_transfer_subscriber_fee_percent": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EntitlementStatus](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"subscribers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": encryptionBlock(ctx),
+					},
+				},
+			},
+			"media_stream": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowMediaStreamModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"clock_rate": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"fmt": schema.Int64Attribute{
+							Computed: true,
+						},
+						"media_stream_id": schema.Int64Attribute{
+							Required: true,
+						},
+						"media_stream_name": schema.StringAttribute{
+							Required: true,
+						},
+						"media_stream_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MediaStreamType](),
+							Required:   true,
+						},
+						"video_format": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"attributes": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamAttributesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"lang": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"fmtp": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[fmtpModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"channel_order": schema.StringAttribute{
+													Optional: true,
+												},
+												"colorimetry": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Colorimetry](),
+													Optional:   true,
+												},
+												"exact_framerate": schema.StringAttribute{
+													Optional: true,
+												},
+												"par": schema.StringAttribute{
+													Optional: true,
+												},
+												"range": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Range](),
+													Optional:   true,
+												},
+												"scan_mode": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ScanMode](),
+													Optional:   true,
+												},
+												"tcs": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Tcs](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						"cidr_allow_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDestination: schema.StringAttribute{
+							Optional: true,
+						},
+						"max_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"output_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputStatus](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrPort: schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Required:   true,
+						},
+						"remote_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"sender_control_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"smoothing_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption":               encryptionBlock(ctx),
+						"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"ingest_ip": schema.StringAttribute{
+							Computed: true,
+						},
+						"ingest_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_bitrate": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_sync_buffer": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"sender_control_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"sender_ip_address": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"source_listener_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_listener_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_interface_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"whitelist_cidr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"decryption": encryptionBlock(ctx),
+						"gateway_bridge_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[gatewayBridgeSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bridge_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vpc_interface": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowVPCInterfaceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"network_interface_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"network_interface_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func encryptionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Algorithm](),
+					Optional:   true,
+				},
+				"constant_initialization_vector": schema.StringAttribute{
+					Optional: true,
+				},
+				"device_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"key_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeyType](),
+					Optional:   true,
+					Computed:   true,
+				},
+				names.AttrRegion: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrResourceID: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"secret_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Optional:   true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func vpcInterfaceAttachmentBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"vpc_interface_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mediaconnect.CreateFlowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Flow.FlowArn)
+
+	// The CreateFlow API does not accept tags.
+	if err := createTags(ctx, conn, arn, getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting MediaConnect Flow (%s) tags", arn), err.Error())
+
+		return
+	}
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	flow, err := waitFlowCreated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if data.Status.ValueEnum() == awstypes.StatusActive {
+		if flow, err = startFlow(ctx, conn, arn, timeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("starting MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(flattenFlow(ctx, flow, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenFlow(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+	status := old.Status.ValueEnum()
+
+	// VPC interfaces and media streams can only be modified while the flow is in standby.
+	if status == awstypes.StatusActive && (!new.VPCInterfaces.Equal(old.VPCInterfaces) || !new.MediaStreams.Equal(old.MediaStreams)) {
+		if _, err := stopFlow(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+
+		status = awstypes.StatusStandby
+	}
+
+	if !new.Maintenance.Equal(old.Maintenance) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		var input mediaconnect.UpdateFlowInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Flow"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Add new VPC interfaces and replace modified ones before any sources or outputs reference them.
+	vpcInterfacesAdd, vpcInterfacesRemove, vpcInterfacesUpdate, d := diffFlowSubEntities(ctx, new.VPCInterfaces, old.VPCInterfaces, func(v *flowVPCInterfaceModel) string {
+		return v.Name.ValueString()
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range vpcInterfacesUpdate {
+		name := fwflex.StringValueFromFramework(ctx, v[1].Name)
+		if err := removeFlowVPCInterface(ctx, conn, arn, name); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) VPC interface (%s)", arn, name), err.Error())
+
+			return
+		}
+
+		vpcInterfacesAdd = append(vpcInterfacesAdd, v[0])
+	}
+
+	if len(vpcInterfacesAdd) > 0 {
+		input := mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, vpcInterfacesAdd), &input.VpcInterfaces)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.AddFlowVpcInterfaces(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) VPC interfaces", arn), err.Error())
+
+			return
+		}
+	}
+
+	mediaStreamsAdd, mediaStreamsRemove, mediaStreamsUpdate, d := diffFlowSubEntities(ctx, new.MediaStreams, old.MediaStreams, func(v *flowMediaStreamModel) string {
+		return v.MediaStreamName.ValueString()
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(mediaStreamsAdd) > 0 {
+		input := mediaconnect.AddFlowMediaStreamsInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, mediaStreamsAdd), &input.MediaStreams)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.AddFlowMediaStreams(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) media streams", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range mediaStreamsUpdate {
+		name := fwflex.StringValueFromFramework(ctx, v[0].MediaStreamName)
+		var input mediaconnect.UpdateFlowMediaStreamInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[0], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowArn = aws.String(arn)
+
+		_, err := conn.UpdateFlowMediaStream(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) media stream (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	// Add sources before removing any so that the flow always has at least one.
+	sourcesAdd, sourcesRemove, sourcesUpdate, d := diffFlowSubEntities(ctx, new.Sources, old.Sources, func(v *flowSourceModel) string {
+		return v.Name.ValueString()
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(sourcesAdd) > 0 {
+		input := mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, sourcesAdd), &input.Sources)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.AddFlowSources(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) sources", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range sourcesUpdate {
+		sourceARN := fwflex.StringValueFromFramework(ctx, v[1].SourceARN)
+		var input mediaconnect.UpdateFlowSourceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[0], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowArn = aws.String(arn)
+		input.SourceArn = aws.String(sourceARN)
+
+		_, err := conn.UpdateFlowSource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) source (%s)", arn, sourceARN), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range sourcesRemove {
+		sourceARN := fwflex.StringValueFromFramework(ctx, v.SourceARN)
+		input := mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: aws.String(sourceARN),
+		}
+		_, err := conn.RemoveFlowSource(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) source (%s)", arn, sourceARN), err.Error())
+
+			return
+		}
+	}
+
+	outputsAdd, outputsRemove, outputsUpdate, d := diffFlowSubEntities(ctx, new.Outputs, old.Outputs, func(v *flowOutputModel) string {
+		return v.Name.ValueString()
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(outputsAdd) > 0 {
+		input := mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, outputsAdd), &input.Outputs)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.AddFlowOutputs(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) outputs", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range outputsUpdate {
+		outputARN := fwflex.StringValueFromFramework(ctx, v[1].OutputARN)
+		if err := updateFlowOutput(ctx, conn, arn, outputARN, v[0]); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) output (%s)", arn, outputARN), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range outputsRemove {
+		outputARN := fwflex.StringValueFromFramework(ctx, v.OutputARN)
+		if err := removeFlowOutput(ctx, conn, arn, outputARN); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) output (%s)", arn, outputARN), err.Error())
+
+			return
+		}
+	}
+
+	entitlementsAdd, entitlementsRemove, entitlementsUpdate, d := diffFlowSubEntities(ctx, new.Entitlements, old.Entitlements, func(v *flowEntitlementModel) string {
+		return v.Name.ValueString()
+	})
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range entitlementsUpdate {
+		entitlementARN := fwflex.StringValueFromFramework(ctx, v[1].EntitlementARN)
+
+		// The data transfer subscriber fee can only be set when the entitlement is granted.
+		if !v[0].DataTransferSubscriberFeePercent.IsUnknown() && !v[0].DataTransferSubscriberFeePercent.Equal(v[1].DataTransferSubscriberFeePercent) {
+			entitlementsRemove = append(entitlementsRemove, v[1])
+			entitlementsAdd = append(entitlementsAdd, v[0])
+
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowEntitlementInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[0], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.EntitlementArn = aws.String(entitlementARN)
+		input.FlowArn = aws.String(arn)
+
+		_, err := conn.UpdateFlowEntitlement(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) entitlement (%s)", arn, entitlementARN), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range entitlementsRemove {
+		entitlementARN := fwflex.StringValueFromFramework(ctx, v.EntitlementARN)
+		input := mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: aws.String(entitlementARN),
+			FlowArn:        aws.String(arn),
+		}
+		_, err := conn.RevokeFlowEntitlement(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("revoking MediaConnect Flow (%s) entitlement (%s)", arn, entitlementARN), err.Error())
+
+			return
+		}
+	}
+
+	if len(entitlementsAdd) > 0 {
+		input := mediaconnect.GrantFlowEntitlementsInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, fwtypes.NewListNestedObjectValueOfSliceMust(ctx, entitlementsAdd), &input.Entitlements)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.GrantFlowEntitlements(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("granting MediaConnect Flow (%s) entitlements", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Media streams and VPC interfaces are removed last as sources and outputs may have referenced them.
+	for _, v := range mediaStreamsRemove {
+		name := fwflex.StringValueFromFramework(ctx, v.MediaStreamName)
+		input := mediaconnect.RemoveFlowMediaStreamInput{
+			FlowArn:         aws.String(arn),
+			MediaStreamName: aws.String(name),
+		}
+		_, err := conn.RemoveFlowMediaStream(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) media stream (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range vpcInterfacesRemove {
+		name := fwflex.StringValueFromFramework(ctx, v.Name)
+		if err := removeFlowVPCInterface(ctx, conn, arn, name); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) VPC interface (%s)", arn, name), err.Error())
+
+			return
+		}
+	}
+
+	flow, err := waitFlowUpdated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+		return
+	}
+
+	switch status, target := flow.Status, new.Status.ValueEnum(); {
+	case target == awstypes.StatusActive && status != awstypes.StatusActive:
+		if flow, err = startFlow(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("starting MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	case target == awstypes.StatusStandby && status == awstypes.StatusActive:
+		if flow, err = stopFlow(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(flattenFlow(ctx, flow, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A running flow must be stopped before it can be deleted.
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if flow.Status == awstypes.StatusActive {
+		if _, err := stopFlow(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "deleting MediaConnect Flow", map[string]any{
+		names.AttrARN: arn,
+	})
+
+	input := mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err = conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, arn, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+// diffFlowSubEntities compares the planned and prior values of one of a flow's lists
+// of sub-entities, matching elements by the specified key.
+// Updated elements are returned as [new, old] pairs.
+func diffFlowSubEntities[T any](ctx context.Context, new, old fwtypes.ListNestedObjectValueOf[T], key func(*T) string) ([]*T, []*T, [][2]*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var add, remove []*T
+	var update [][2]*T
+
+	newElems, d := new.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, nil, diags
+	}
+	oldElems, d := old.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, nil, diags
+	}
+
+	oldByKey := make(map[string]*T, len(oldElems))
+	for _, v := range oldElems {
+		oldByKey[key(v)] = v
+	}
+
+	for _, v := range newElems {
+		o, ok := oldByKey[key(v)]
+		if !ok {
+			add = append(add, v)
+			continue
+		}
+		delete(oldByKey, key(v))
+
+		diff, d := fwflex.Diff(ctx, v, o)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, nil, nil, diags
+		}
+
+		if diff.HasChanges() {
+			update = append(update, [2]*T{v, o})
+		}
+	}
+
+	for _, v := range oldElems {
+		if _, ok := oldByKey[key(v)]; ok {
+			remove = append(remove, v)
+		}
+	}
+
+	return add, remove, update, diags
+}
+
+func updateFlowOutput(ctx context.Context, conn *mediaconnect.Client, flowARN, outputARN string, data *flowOutputModel) error {
+	var input mediaconnect.UpdateFlowOutputInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	// Additional fields.
+	input.FlowArn = aws.String(flowARN)
+	input.OutputArn = aws.String(outputARN)
+
+	_, err := conn.UpdateFlowOutput(ctx, &input)
+
+	return err
+}
+
+func removeFlowOutput(ctx context.Context, conn *mediaconnect.Client, flowARN, outputARN string) error {
+	input := mediaconnect.RemoveFlowOutputInput{
+		FlowArn:   aws.String(flowARN),
+		OutputArn: aws.String(outputARN),
+	}
+	_, err := conn.RemoveFlowOutput(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func removeFlowVPCInterface(ctx context.Context, conn *mediaconnect.Client, flowARN, name string) error {
+	input := mediaconnect.RemoveFlowVpcInterfaceInput{
+		FlowArn:          aws.String(flowARN),
+		VpcInterfaceName: aws.String(name),
+	}
+	_, err := conn.RemoveFlowVpcInterface(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	input := mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	if _, err := conn.StartFlow(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	return waitFlowStarted(ctx, conn, arn, timeout)
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	input := mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	if _, err := conn.StopFlow(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	return waitFlowStopped(ctx, conn, arn, timeout)
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	output, err := conn.DescribeFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive, awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStarted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusStandby, awstypes.StatusStarting, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStandby),
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenFlow flattens the specified flow into the resource model.
+// Sub-entity lists are kept in their prior order, and outputs are only flattened when
+// they were previously configured inline so that aws_mediaconnect_flow_output can be used instead.
+func flattenFlow(ctx context.Context, flow *awstypes.Flow, data *flowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(flow.Sources) == 0 && flow.Source != nil {
+		flow.Sources = []awstypes.Source{*flow.Source}
+	}
+
+	sortByPriorOrder(ctx, flow.Entitlements, data.Entitlements, func(v awstypes.Entitlement) string {
+		return aws.ToString(v.Name)
+	}, func(v *flowEntitlementModel) string {
+		return v.Name.ValueString()
+	})
+	sortByPriorOrder(ctx, flow.MediaStreams, data.MediaStreams, func(v awstypes.MediaStream) string {
+		return aws.ToString(v.MediaStreamName)
+	}, func(v *flowMediaStreamModel) string {
+		return v.MediaStreamName.ValueString()
+	})
+	sortByPriorOrder(ctx, flow.Outputs, data.Outputs, func(v awstypes.Output) string {
+		return aws.ToString(v.Name)
+	}, func(v *flowOutputModel) string {
+		return v.Name.ValueString()
+	})
+	sortByPriorOrder(ctx, flow.Sources, data.Sources, func(v awstypes.Source) string {
+		return aws.ToString(v.Name)
+	}, func(v *flowSourceModel) string {
+		return v.Name.ValueString()
+	})
+	sortByPriorOrder(ctx, flow.VpcInterfaces, data.VPCInterfaces, func(v awstypes.VpcInterface) string {
+		return aws.ToString(v.Name)
+	}, func(v *flowVPCInterfaceModel) string {
+		return v.Name.ValueString()
+	})
+
+	outputs := data.Outputs
+
+	diags.Append(fwflex.Flatten(ctx, flow, data, fwflex.WithFieldNamePrefix("Flow"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if elems, d := outputs.ToSlice(ctx); d.HasError() || len(elems) == 0 {
+		data.Outputs = outputs
+	}
+
+	return diags
+}
+
+// sortByPriorOrder sorts API objects so that those matching prior model elements keep the prior order.
+func sortByPriorOrder[T, M any](ctx context.Context, apiObjects []T, prior fwtypes.ListNestedObjectValueOf[M], apiObjectKey func(T) string, modelKey func(*M) string) {
+	elems, diags := prior.ToSlice(ctx)
+	if diags.HasError() {
+		return
+	}
+
+	index := make(map[string]int, len(elems))
+	for i, v := range elems {
+		index[modelKey(v)] = i
+	}
+	position := func(v T) int {
+		if i, ok := index[apiObjectKey(v)]; ok {
+			return i
+		}
+		return len(elems)
+	}
+
+	slices.SortStableFunc(apiObjects, func(a, b T) int {
+		return cmp.Compare(position(a), position(b))
+	})
+}
+
+type flowResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                           `tfsdk:"arn"`
+	AvailabilityZone     types.String                                           `tfsdk:"availability_zone"`
+	EgressIP             types.String                                           `tfsdk:"egress_ip"`
+	Entitlements         fwtypes.ListNestedObjectValueOf[flowEntitlementModel]  `tfsdk:"entitlement"`
+	FlowSize             fwtypes.StringEnum[awstypes.FlowSize]                  `tfsdk:"flow_size"`
+	Maintenance          fwtypes.ListNestedObjectValueOf[flowMaintenanceModel]  `tfsdk:"maintenance"`
+	MediaStreams         fwtypes.ListNestedObjectValueOf[flowMediaStreamModel]  `tfsdk:"media_stream"`
+	Name                 types.String                                           `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[flowOutputModel]       `tfsdk:"output"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]   `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[flowSourceModel]       `tfsdk:"source"`
+	Status               fwtypes.StringEnum[awstypes.Status]                    `tfsdk:"status"`
+	Tags                 tftags.Map                                             `tfsdk:"tags"`
+	TagsAll              tftags.Map                                             `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                         `tfsdk:"timeouts"`
+	VPCInterfaces        fwtypes.ListNestedObjectValueOf[flowVPCInterfaceModel] `tfsdk:"vpc_interface"`
+}
+
+type encryptionModel struct {
+	Algorithm                    fwtypes.StringEnum[awstypes.Algorithm] `tfsdk:"algorithm"`
+	ConstantInitializationVector types.String                           `tfsdk:"constant_initialization_vector"`
+	DeviceID                     types.String                           `tfsdk:"device_id"`
+	KeyType                      fwtypes.StringEnum[awstypes.KeyType]   `tfsdk:"key_type"`
+	Region                       types.String                           `tfsdk:"region"`
+	ResourceID                   types.String                           `tfsdk:"resource_id"`
+	RoleARN                      fwtypes.ARN                            `tfsdk:"role_arn"`
+	SecretARN                    fwtypes.ARN                            `tfsdk:"secret_arn"`
+	URL                          types.String                           `tfsdk:"url"`
+}
+
+type failoverConfigModel struct {
+	FailoverMode   fwtypes.StringEnum[awstypes.FailoverMode]            `tfsdk:"failover_mode"`
+	RecoveryWindow types.Int64                                          `tfsdk:"recovery_window"`
+	SourcePriority fwtypes.ListNestedObjectValueOf[sourcePriorityModel] `tfsdk:"source_priority"`
+	State          fwtypes.StringEnum[awstypes.State]                   `tfsdk:"state"`
+}
+
+type sourcePriorityModel struct {
+	PrimarySource types.String `tfsdk:"primary_source"`
+}
+
+type flowEntitlementModel struct {
+	DataTransferSubscriberFeePercent types.Int64                                      `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                     `tfsdk:"description"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	EntitlementARN                   types.String                                     `tfsdk:"arn"`
+	EntitlementStatus                fwtypes.StringEnum[awstypes.EntitlementStatus]   `tfsdk:"entitlement_status"`
+	Name                             types.String                                     `tfsdk:"name"`
+	Subscribers                      fwtypes.SetOfString                              `tfsdk:"subscribers"`
+}
+
+type flowMaintenanceModel struct {
+	MaintenanceDay       fwtypes.StringEnum[awstypes.MaintenanceDay] `tfsdk:"maintenance_day"`
+	MaintenanceStartHour types.String                                `tfsdk:"maintenance_start_hour"`
+}
+
+type flowMediaStreamModel struct {
+	Attributes      fwtypes.ListNestedObjectValueOf[mediaStreamAttributesModel] `tfsdk:"attributes"`
+	ClockRate       types.Int64                                                 `tfsdk:"clock_rate"`
+	Description     types.String                                                `tfsdk:"description"`
+	Fmt             types.Int64                                                 `tfsdk:"fmt"`
+	MediaStreamID   types.Int64                                                 `tfsdk:"media_stream_id"`
+	MediaStreamName types.String                                                `tfsdk:"media_stream_name"`
+	MediaStreamType fwtypes.StringEnum[awstypes.MediaStreamType]                `tfsdk:"media_stream_type"`
+	VideoFormat     types.String                                                `tfsdk:"video_format"`
+}
+
+type mediaStreamAttributesModel struct {
+	Fmtp fwtypes.ListNestedObjectValueOf[fmtpModel] `tfsdk:"fmtp"`
+	Lang types.String                               `tfsdk:"lang"`
+}
+
+type fmtpModel struct {
+	ChannelOrder   types.String                             `tfsdk:"channel_order"`
+	Colorimetry    fwtypes.StringEnum[awstypes.Colorimetry] `tfsdk:"colorimetry"`
+	ExactFramerate types.String                             `tfsdk:"exact_framerate"`
+	Par            types.String                             `tfsdk:"par"`
+	Range          fwtypes.StringEnum[awstypes.Range]       `tfsdk:"range"`
+	ScanMode       fwtypes.StringEnum[awstypes.ScanMode]    `tfsdk:"scan_mode"`
+	Tcs            fwtypes.StringEnum[awstypes.Tcs]         `tfsdk:"tcs"`
+}
+
+type flowOutputModel struct {
+	CIDRAllowList          fwtypes.ListOfString                                         `tfsdk:"cidr_allow_list"`
+	Description            types.String                                                 `tfsdk:"description"`
+	Destination            types.String                                                 `tfsdk:"destination"`
+	Encryption             fwtypes.ListNestedObjectValueOf[encryptionModel]             `tfsdk:"encryption"`
+	MaxLatency             types.Int64                                                  `tfsdk:"max_latency"`
+	MinLatency             types.Int64                                                  `tfsdk:"min_latency"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	OutputARN              types.String                                                 `tfsdk:"arn"`
+	OutputStatus           fwtypes.StringEnum[awstypes.OutputStatus]                    `tfsdk:"output_status"`
+	Port                   types.Int64                                                  `tfsdk:"port"`
+	Protocol               fwtypes.StringEnum[awstypes.Protocol]                        `tfsdk:"protocol"`
+	RemoteID               types.String                                                 `tfsdk:"remote_id"`
+	SenderControlPort      types.Int64                                                  `tfsdk:"sender_control_port"`
+	SmoothingLatency       types.Int64                                                  `tfsdk:"smoothing_latency"`
+	StreamID               types.String                                                 `tfsdk:"stream_id"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+// flowOutputData has the same fields as flowOutputModel but does not implement fwflex.Flattener.
+type flowOutputData flowOutputModel
+
+var (
+	_ fwflex.Flattener = &flowOutputModel{}
+)
+
+func (m *flowOutputModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.Output:
+		data := (*flowOutputData)(m)
+		diags.Append(fwflex.Flatten(ctx, t, data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Protocol and latency settings are returned in a nested structure.
+		transport := t.Transport
+		if transport == nil {
+			transport = &awstypes.Transport{}
+		}
+		diags.Append(fwflex.Flatten(ctx, transport, data)...)
+	}
+
+	return diags
+}
+
+type flowSourceModel struct {
+	Decryption            fwtypes.ListNestedObjectValueOf[encryptionModel]          `tfsdk:"decryption"`
+	Description           types.String                                              `tfsdk:"description"`
+	EntitlementARN        fwtypes.ARN                                               `tfsdk:"entitlement_arn"`
+	GatewayBridgeSource   fwtypes.ListNestedObjectValueOf[gatewayBridgeSourceModel] `tfsdk:"gateway_bridge_source"`
+	IngestIP              types.String                                              `tfsdk:"ingest_ip"`
+	IngestPort            types.Int64                                               `tfsdk:"ingest_port"`
+	MaxBitrate            types.Int64                                               `tfsdk:"max_bitrate"`
+	MaxLatency            types.Int64                                               `tfsdk:"max_latency"`
+	MaxSyncBuffer         types.Int64                                               `tfsdk:"max_sync_buffer"`
+	MinLatency            types.Int64                                               `tfsdk:"min_latency"`
+	Name                  types.String                                              `tfsdk:"name"`
+	Protocol              fwtypes.StringEnum[awstypes.Protocol]                     `tfsdk:"protocol"`
+	SenderControlPort     types.Int64                                               `tfsdk:"sender_control_port"`
+	SenderIPAddress       types.String                                              `tfsdk:"sender_ip_address"`
+	SourceARN             types.String                                              `tfsdk:"arn"`
+	SourceListenerAddress types.String                                              `tfsdk:"source_listener_address"`
+	SourceListenerPort    types.Int64                                               `tfsdk:"source_listener_port"`
+	StreamID              types.String                                              `tfsdk:"stream_id"`
+	VPCInterfaceName      types.String                                              `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR         types.String                                              `tfsdk:"whitelist_cidr"`
+}
+
+// flowSourceData has the same fields as flowSourceModel but does not implement fwflex.Flattener.
+type flowSourceData flowSourceModel
+
+var (
+	_ fwflex.Flattener = &flowSourceModel{}
+)
+
+func (m *flowSourceModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.Source:
+		data := (*flowSourceData)(m)
+		diags.Append(fwflex.Flatten(ctx, t, data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Protocol and latency settings are returned in a nested structure.
+		transport := t.Transport
+		if transport == nil {
+			transport = &awstypes.Transport{}
+		}
+		diags.Append(fwflex.Flatten(ctx, transport, data)...)
+	}
+
+	return diags
+}
+
+type gatewayBridgeSourceModel struct {
+	BridgeARN              fwtypes.ARN                                                  `tfsdk:"bridge_arn"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+type vpcInterfaceAttachmentModel struct {
+	VPCInterfaceName types.String `tfsdk:"vpc_interface_name"`
+}
+
+type flowVPCInterfaceModel struct {
+	Name                 types.String                                      `tfsdk:"name"`
+	NetworkInterfaceIDs  fwtypes.ListOfString                              `tfsdk:"network_interface_ids"`
+	NetworkInterfaceType fwtypes.StringEnum[awstypes.NetworkInterfaceType] `tfsdk:"network_interface_type"`
+	RoleARN              fwtypes.ARN                                       `tfsdk:"role_arn"`
+	SecurityGroupIDs     fwtypes.SetOfString                               `tfsdk:"security_group_ids"`
+	SubnetID             types.String                                      `tfsdk:"subnet_id"`
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow_output", name="Flow Output")
+// @IdentityAttribute("flow_arn")
+// @IdentityAttribute("arn")
+// @ImportIDHandler("flowOutputImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Output")
+// @Testing(importStateIdFunc="testAccFlowOutputImportStateIDFunc")
+// @Testing(hasNoPreExistingResource=true)
+func newFlowOutputResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &flowOutputResource{}, nil
+}
+
+type flowOutputResource struct {
+	framework.ResourceWithModel[flowOutputResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *flowOutputResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cidr_allow_list": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrDestination: schema.StringAttribute{
+				Optional: true,
+			},
+			"flow_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_latency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_latency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OutputStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrProtocol: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+				Required:   true,
+			},
+			"remote_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"sender_control_port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"smoothing_latency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"stream_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption":               encryptionBlock(ctx),
+			"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+		},
+	}
+}
+
+func (r *flowOutputResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowOutputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flowARN, name := fwflex.StringValueFromFramework(ctx, data.FlowARN), fwflex.StringValueFromFramework(ctx, data.Name)
+	var outputInput awstypes.AddOutputRequest
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.flowOutputModel, &outputInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediaconnect.AddFlowOutputsInput{
+		FlowArn: aws.String(flowARN),
+		Outputs: []awstypes.AddOutputRequest{outputInput},
+	}
+	output, err := conn.AddFlowOutputs(ctx, &input)
+
+	if err == nil && len(output.Outputs) == 0 {
+		err = tfresource.NewEmptyResultError(&input)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Output (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Outputs[0], &data.flowOutputModel)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowOutputResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowOutputResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flowARN, outputARN := fwflex.StringValueFromFramework(ctx, data.FlowARN), fwflex.StringValueFromFramework(ctx, data.OutputARN)
+	output, err := findFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s) Output (%s)", flowARN, outputARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.flowOutputModel)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowOutputResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowOutputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flowARN, outputARN := fwflex.StringValueFromFramework(ctx, new.FlowARN), fwflex.StringValueFromFramework(ctx, new.OutputARN)
+	if err := updateFlowOutput(ctx, conn, flowARN, outputARN, &new.flowOutputModel); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) Output (%s)", flowARN, outputARN), err.Error())
+
+		return
+	}
+
+	output, err := findFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s) Output (%s)", flowARN, outputARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new.flowOutputModel)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowOutputResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowOutputResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flowARN, outputARN := fwflex.StringValueFromFramework(ctx, data.FlowARN), fwflex.StringValueFromFramework(ctx, data.OutputARN)
+	tflog.Debug(ctx, "deleting MediaConnect Flow Output", map[string]any{
+		"flow_arn":    flowARN,
+		names.AttrARN: outputARN,
+	})
+
+	if err := removeFlowOutput(ctx, conn, flowARN, outputARN); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow (%s) Output (%s)", flowARN, outputARN), err.Error())
+
+		return
+	}
+}
+
+func findFlowOutputByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, outputARN string) (*awstypes.Output, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(flow.Outputs, func(v awstypes.Output) bool {
+		return aws.ToString(v.OutputArn) == outputARN
+	}))
+}
+
+type flowOutputResourceModel struct {
+	framework.WithRegionModel
+	flowOutputModel
+	FlowARN fwtypes.ARN `tfsdk:"flow_arn"`
+}
+
+var (
+	_ inttypes.ImportIDParser = flowOutputImportID{}
+)
+
+type flowOutputImportID struct{}
+
+func (flowOutputImportID) Parse(id string) (string, map[string]string, error) {
+	flowARN, outputARN, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <flow-arn>"+intflex.ResourceIdSeparator+"<output-arn>", id)
+	}
+
+	result := map[string]string{
+		"flow_arn":    flowARN,
+		names.AttrARN: outputARN,
+	}
+
+	return id, result, nil
+}
@@ -0,0 +1,36 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mediaconnect_flow_output" "test" {
+  region = var.region
+
+  flow_arn    = aws_mediaconnect_flow.test.arn
+  name        = var.rName
+  protocol    = "rtp"
+  destination = "192.0.2.10"
+  port        = 5010
+}
+
+resource "aws_mediaconnect_flow" "test" {
+  region = var.region
+
+  name = var.rName
+
+  source {
+    name           = var.rName
+    protocol       = "rtp"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagevod/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_media_packagevod_asset", name="Asset")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("asset_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediapackagevod;mediapackagevod.DescribeAssetOutput")
+// @Testing(importStateIdAttribute="asset_id")
+// Testing requires a pre-existing source media object in S3
+// @Testing(tagsTest=false, identityTest=false)
+func newAssetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &assetResource{}, nil
+}
+
+type assetResource struct {
+	framework.ResourceWithModel[assetResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *assetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"asset_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"egress_endpoints": framework.ResourceComputedListOfObjectsAttribute[egressEndpointModel](ctx, listplanmodifier.UseStateForUnknown()),
+			"packaging_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *assetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data assetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.AssetID)
+	var input mediapackagevod.CreateAssetInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("Asset"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAsset(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage VOD Asset (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Asset"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *assetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data assetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.AssetID)
+	output, err := findAssetByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage VOD Asset (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Asset"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *assetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data assetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.AssetID)
+	tflog.Debug(ctx, "deleting MediaPackage VOD Asset", map[string]any{
+		"asset_id": id,
+	})
+	input := mediapackagevod.DeleteAssetInput{
+		Id: aws.String(id),
+	}
+	_, err := conn.DeleteAsset(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage VOD Asset (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func findAssetByID(ctx context.Context, conn *mediapackagevod.Client, id string) (*mediapackagevod.DescribeAssetOutput, error) {
+	input := mediapackagevod.DescribeAssetInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeAsset(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Id == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type assetResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                         `tfsdk:"arn"`
+	AssetID          types.String                                         `tfsdk:"asset_id"`
+	EgressEndpoints  fwtypes.ListNestedObjectValueOf[egressEndpointModel] `tfsdk:"egress_endpoints"`
+	PackagingGroupID types.String                                         `tfsdk:"packaging_group_id"`
+	ResourceID       types.String                                         `tfsdk:"resource_id"`
+	SourceARN        fwtypes.ARN                                          `tfsdk:"source_arn"`
+	SourceRoleARN    fwtypes.ARN                                          `tfsdk:"source_role_arn"`
+	Tags             tftags.Map                                           `tfsdk:"tags"`
+	TagsAll          tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type egressEndpointModel struct {
+	PackagingConfigurationID types.String `tfsdk:"packaging_configuration_id"`
+	Status                   types.String `tfsdk:"status"`
+	URL                      types.String `tfsdk:"url"`
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagevod "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// envVarAssetSourceARN is the ARN of an S3 object (.mp4, .m3u8 or .smil) to ingest.
+const envVarAssetSourceARN = "MEDIAPACKAGEVOD_ASSET_SOURCE_ARN"
+
+func TestAccMediaPackageVODAsset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceARN := acctest.SkipIfEnvVarNotSet(t, envVarAssetSourceARN)
+	var v mediapackagevod.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName, sourceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("mediapackage-vod", "assets/"+rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("asset_id"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("egress_endpoints"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrResourceID), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("source_arn"), knownvalue.StringExact(sourceARN)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "asset_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "asset_id",
+				ImportStateVerifyIgnore:              []string{"egress_endpoints"},
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODAsset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceARN := acctest.SkipIfEnvVarNotSet(t, envVarAssetSourceARN)
+	var v mediapackagevod.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName, sourceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagevod.ResourceAsset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODAsset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceARN := acctest.SkipIfEnvVarNotSet(t, envVarAssetSourceARN)
+	var v mediapackagevod.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_tags1(rName, sourceARN, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				Config: testAccAssetConfig_tags1(rName, sourceARN, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAssetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagevod_asset" {
+				continue
+			}
+
+			_, err := tfmediapackagevod.FindAssetByID(ctx, conn, rs.Primary.Attributes["asset_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage VOD Asset %s still exists", rs.Primary.Attributes["asset_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssetExists(ctx context.Context, n string, v *mediapackagevod.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		output, err := tfmediapackagevod.FindAssetByID(ctx, conn, rs.Primary.Attributes["asset_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAssetConfig_base(rName, sourceARN string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_hls(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediapackage.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:GetBucketRequestPayment",
+        "s3:GetObject",
+        "s3:ListBucket",
+      ]
+      Resource = [
+        %[2]q,
+        split("/", %[2]q)[0],
+      ]
+    }]
+  })
+}
+`, rName, sourceARN))
+}
+
+func testAccAssetConfig_basic(rName, sourceARN string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName, sourceARN), fmt.Sprintf(`
+resource "aws_media_packagevod_asset" "test" {
+  asset_id           = %[1]q
+  packaging_group_id = aws_media_packagevod_packaging_group.test.packaging_group_id
+  source_arn         = %[2]q
+  source_role_arn    = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test, aws_media_packagevod_packaging_configuration.test]
+}
+`, rName, sourceARN))
+}
+
+func testAccAssetConfig_tags1(rName, sourceARN, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName, sourceARN), fmt.Sprintf(`
+resource "aws_media_packagevod_asset" "test" {
+  asset_id           = %[1]q
+  packaging_group_id = aws_media_packagevod_packaging_group.test.packaging_group_id
+  source_arn         = %[2]q
+  source_role_arn    = aws_iam_role.test.arn
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_media_packagevod_packaging_configuration.test]
+}
+`, rName, sourceARN, tagKey1, tagValue1))
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+// Exports for use in tests only.
+var (
+	ResourceAsset                  = newAssetResource
+	ResourcePackagingConfiguration = newPackagingConfigurationResource
+	ResourcePackagingGroup         = newPackagingGroupResource
+
+	FindAssetByID                  = findAssetByID
+	FindPackagingConfigurationByID = findPackagingConfigurationByID
+	FindPackagingGroupByID         = findPackagingGroupByID
+)
@@ -0,0 +1,606 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagevod/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_media_packagevod_packaging_configuration", name="Packaging Configuration")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("packaging_configuration_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediapackagevod;mediapackagevod.DescribePackagingConfigurationOutput")
+// @Testing(importStateIdAttribute="packaging_configuration_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newPackagingConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &packagingConfigurationResource{}, nil
+}
+
+type packagingConfigurationResource struct {
+	framework.ResourceWithModel[packagingConfigurationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *packagingConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	packageValidators := func(name string) []validator.List {
+		var paths []path.Expression
+		for _, v := range []string{"cmaf_package", "dash_package", "hls_package", "mss_package"} {
+			if v != name {
+				paths = append(paths, path.MatchRoot(v))
+			}
+		}
+
+		return []validator.List{
+			listvalidator.SizeAtMost(1),
+			listvalidator.ExactlyOneOf(paths...),
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"packaging_configuration_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"packaging_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"cmaf_package": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cmafPackageModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: packageValidators("cmaf_package"),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_encoder_configuration_in_segments": optionalComputedBoolAttribute(),
+						"segment_duration_seconds":                  optionalComputedInt32Attribute(),
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cmafEncryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"constant_initialization_vector": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"speke_key_provider": spekeKeyProviderBlock(ctx),
+								},
+							},
+						},
+						"hls_manifest": hlsManifestBlock(ctx),
+					},
+				},
+			},
+			"dash_package": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashPackageModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: packageValidators("dash_package"),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_encoder_configuration_in_segments": optionalComputedBoolAttribute(),
+						"include_iframe_only_stream":                optionalComputedBoolAttribute(),
+						"period_triggers": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringEnumType[awstypes.PeriodTriggersElement](),
+							ElementType: fwtypes.StringEnumType[awstypes.PeriodTriggersElement](),
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"segment_duration_seconds": optionalComputedInt32Attribute(),
+						"segment_template_format":  optionalComputedStringEnumAttribute[awstypes.SegmentTemplateFormat](),
+					},
+					Blocks: map[string]schema.Block{
+						"dash_manifest": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashManifestModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"manifest_layout":         optionalComputedStringEnumAttribute[awstypes.ManifestLayout](),
+									"manifest_name":           optionalComputedStringAttribute(),
+									"min_buffer_time_seconds": optionalComputedInt32Attribute(),
+									"profile":                 optionalComputedStringEnumAttribute[awstypes.Profile](),
+									"scte_markers_source":     optionalComputedStringEnumAttribute[awstypes.ScteMarkersSource](),
+								},
+								Blocks: map[string]schema.Block{
+									"stream_selection": streamSelectionBlock(ctx),
+								},
+							},
+						},
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashEncryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"speke_key_provider": spekeKeyProviderBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"hls_package": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[hlsPackageModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: packageValidators("hls_package"),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_dvb_subtitles":     optionalComputedBoolAttribute(),
+						"segment_duration_seconds":  optionalComputedInt32Attribute(),
+						"use_audio_rendition_group": optionalComputedBoolAttribute(),
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[hlsEncryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"constant_initialization_vector": schema.StringAttribute{
+										Optional: true,
+									},
+									"encryption_method": optionalComputedStringEnumAttribute[awstypes.EncryptionMethod](),
+								},
+								Blocks: map[string]schema.Block{
+									"speke_key_provider": spekeKeyProviderBlock(ctx),
+								},
+							},
+						},
+						"hls_manifest": hlsManifestBlock(ctx),
+					},
+				},
+			},
+			"mss_package": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[mssPackageModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: packageValidators("mss_package"),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"segment_duration_seconds": optionalComputedInt32Attribute(),
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mssEncryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"speke_key_provider": spekeKeyProviderBlock(ctx),
+								},
+							},
+						},
+						"mss_manifest": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mssManifestModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"manifest_name": optionalComputedStringAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"stream_selection": streamSelectionBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func optionalComputedBoolAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalComputedInt32Attribute() schema.Int32Attribute {
+	return schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalComputedStringAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalComputedStringEnumAttribute[T enum.Valueser[T]]() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[T](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func hlsManifestBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[hlsManifestModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"ad_markers":                         optionalComputedStringEnumAttribute[awstypes.AdMarkers](),
+				"include_iframe_only_stream":         optionalComputedBoolAttribute(),
+				"manifest_name":                      optionalComputedStringAttribute(),
+				"program_date_time_interval_seconds": optionalComputedInt32Attribute(),
+				"repeat_ext_x_key":                   optionalComputedBoolAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"stream_selection": streamSelectionBlock(ctx),
+			},
+		},
+	}
+}
+
+func spekeKeyProviderBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[spekeKeyProviderModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"system_ids": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"encryption_contract_configuration": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionContractConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"preset_speke20_audio": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Audio](),
+								Required:   true,
+							},
+							"preset_speke20_video": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Video](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func streamSelectionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[streamSelectionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"max_video_bits_per_second": optionalComputedInt32Attribute(),
+				"min_video_bits_per_second": optionalComputedInt32Attribute(),
+				"stream_order":              optionalComputedStringEnumAttribute[awstypes.StreamOrder](),
+			},
+		},
+	}
+}
+
+func (r *packagingConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data packagingConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.PackagingConfigurationID)
+	var input mediapackagevod.CreatePackagingConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("PackagingConfiguration"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePackagingConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage VOD Packaging Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("PackagingConfiguration"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *packagingConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data packagingConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.PackagingConfigurationID)
+	output, err := findPackagingConfigurationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage VOD Packaging Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("PackagingConfiguration"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *packagingConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data packagingConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.PackagingConfigurationID)
+	tflog.Debug(ctx, "deleting MediaPackage VOD Packaging Configuration", map[string]any{
+		"packaging_configuration_id": id,
+	})
+	input := mediapackagevod.DeletePackagingConfigurationInput{
+		Id: aws.String(id),
+	}
+	_, err := conn.DeletePackagingConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage VOD Packaging Configuration (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func findPackagingConfigurationByID(ctx context.Context, conn *mediapackagevod.Client, id string) (*mediapackagevod.DescribePackagingConfigurationOutput, error) {
+	input := mediapackagevod.DescribePackagingConfigurationInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribePackagingConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Id == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type packagingConfigurationResourceModel struct {
+	framework.WithRegionModel
+	ARN                      types.String                                      `tfsdk:"arn"`
+	CmafPackage              fwtypes.ListNestedObjectValueOf[cmafPackageModel] `tfsdk:"cmaf_package"`
+	DashPackage              fwtypes.ListNestedObjectValueOf[dashPackageModel] `tfsdk:"dash_package"`
+	HlsPackage               fwtypes.ListNestedObjectValueOf[hlsPackageModel]  `tfsdk:"hls_package"`
+	MssPackage               fwtypes.ListNestedObjectValueOf[mssPackageModel]  `tfsdk:"mss_package"`
+	PackagingConfigurationID types.String                                      `tfsdk:"packaging_configuration_id"`
+	PackagingGroupID         types.String                                      `tfsdk:"packaging_group_id"`
+	Tags                     tftags.Map                                        `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                        `tfsdk:"tags_all"`
+}
+
+type cmafPackageModel struct {
+	Encryption                            fwtypes.ListNestedObjectValueOf[cmafEncryptionModel] `tfsdk:"encryption"`
+	HlsManifests                          fwtypes.ListNestedObjectValueOf[hlsManifestModel]    `tfsdk:"hls_manifest"`
+	IncludeEncoderConfigurationInSegments types.Bool                                           `tfsdk:"include_encoder_configuration_in_segments"`
+	SegmentDurationSeconds                types.Int32                                          `tfsdk:"segment_duration_seconds"`
+}
+
+type cmafEncryptionModel struct {
+	ConstantInitializationVector types.String                                           `tfsdk:"constant_initialization_vector"`
+	SpekeKeyProvider             fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type dashPackageModel struct {
+	DashManifests                         fwtypes.ListNestedObjectValueOf[dashManifestModel]       `tfsdk:"dash_manifest"`
+	Encryption                            fwtypes.ListNestedObjectValueOf[dashEncryptionModel]     `tfsdk:"encryption"`
+	IncludeEncoderConfigurationInSegments types.Bool                                               `tfsdk:"include_encoder_configuration_in_segments"`
+	IncludeIframeOnlyStream               types.Bool                                               `tfsdk:"include_iframe_only_stream"`
+	PeriodTriggers                        fwtypes.ListOfStringEnum[awstypes.PeriodTriggersElement] `tfsdk:"period_triggers"`
+	SegmentDurationSeconds                types.Int32                                              `tfsdk:"segment_duration_seconds"`
+	SegmentTemplateFormat                 fwtypes.StringEnum[awstypes.SegmentTemplateFormat]       `tfsdk:"segment_template_format"`
+}
+
+type dashManifestModel struct {
+	ManifestLayout       fwtypes.StringEnum[awstypes.ManifestLayout]           `tfsdk:"manifest_layout"`
+	ManifestName         types.String                                          `tfsdk:"manifest_name"`
+	MinBufferTimeSeconds types.Int32                                           `tfsdk:"min_buffer_time_seconds"`
+	Profile              fwtypes.StringEnum[awstypes.Profile]                  `tfsdk:"profile"`
+	ScteMarkersSource    fwtypes.StringEnum[awstypes.ScteMarkersSource]        `tfsdk:"scte_markers_source"`
+	StreamSelection      fwtypes.ListNestedObjectValueOf[streamSelectionModel] `tfsdk:"stream_selection"`
+}
+
+type dashEncryptionModel struct {
+	SpekeKeyProvider fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type hlsPackageModel struct {
+	Encryption             fwtypes.ListNestedObjectValueOf[hlsEncryptionModel] `tfsdk:"encryption"`
+	HlsManifests           fwtypes.ListNestedObjectValueOf[hlsManifestModel]   `tfsdk:"hls_manifest"`
+	IncludeDvbSubtitles    types.Bool                                          `tfsdk:"include_dvb_subtitles"`
+	SegmentDurationSeconds types.Int32                                         `tfsdk:"segment_duration_seconds"`
+	UseAudioRenditionGroup types.Bool                                          `tfsdk:"use_audio_rendition_group"`
+}
+
+type hlsEncryptionModel struct {
+	ConstantInitializationVector types.String                                           `tfsdk:"constant_initialization_vector"`
+	EncryptionMethod             fwtypes.StringEnum[awstypes.EncryptionMethod]          `tfsdk:"encryption_method"`
+	SpekeKeyProvider             fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type hlsManifestModel struct {
+	AdMarkers                      fwtypes.StringEnum[awstypes.AdMarkers]                `tfsdk:"ad_markers"`
+	IncludeIframeOnlyStream        types.Bool                                            `tfsdk:"include_iframe_only_stream"`
+	ManifestName                   types.String                                          `tfsdk:"manifest_name"`
+	ProgramDateTimeIntervalSeconds types.Int32                                           `tfsdk:"program_date_time_interval_seconds"`
+	RepeatExtXKey                  types.Bool                                            `tfsdk:"repeat_ext_x_key"`
+	StreamSelection                fwtypes.ListNestedObjectValueOf[streamSelectionModel] `tfsdk:"stream_selection"`
+}
+
+type mssPackageModel struct {
+	Encryption             fwtypes.ListNestedObjectValueOf[mssEncryptionModel] `tfsdk:"encryption"`
+	MssManifests           fwtypes.ListNestedObjectValueOf[mssManifestModel]   `tfsdk:"mss_manifest"`
+	SegmentDurationSeconds types.Int32                                         `tfsdk:"segment_duration_seconds"`
+}
+
+type mssEncryptionModel struct {
+	SpekeKeyProvider fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type mssManifestModel struct {
+	ManifestName    types.String                                          `tfsdk:"manifest_name"`
+	StreamSelection fwtypes.ListNestedObjectValueOf[streamSelectionModel] `tfsdk:"stream_selection"`
+}
+
+type spekeKeyProviderModel struct {
+	EncryptionContractConfiguration fwtypes.ListNestedObjectValueOf[encryptionContractConfigurationModel] `tfsdk:"encryption_contract_configuration"`
+	RoleARN                         fwtypes.ARN                                                           `tfsdk:"role_arn"`
+	SystemIDs                       fwtypes.ListOfString                                                  `tfsdk:"system_ids"`
+	URL                             types.String                                                          `tfsdk:"url"`
+}
+
+type encryptionContractConfigurationModel struct {
+	PresetSpeke20Audio fwtypes.StringEnum[awstypes.PresetSpeke20Audio] `tfsdk:"preset_speke20_audio"`
+	PresetSpeke20Video fwtypes.StringEnum[awstypes.PresetSpeke20Video] `tfsdk:"preset_speke20_video"`
+}
+
+type streamSelectionModel struct {
+	MaxVideoBitsPerSecond types.Int32                              `tfsdk:"max_video_bits_per_second"`
+	MinVideoBitsPerSecond types.Int32                              `tfsdk:"min_video_bits_per_second"`
+	StreamOrder           fwtypes.StringEnum[awstypes.StreamOrder] `tfsdk:"stream_order"`
+}
@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagevod "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageVODPackagingConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_hls(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("mediapackage-vod", "packaging-configurations/"+rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("cmaf_package"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("dash_package"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hls_package"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("mss_package"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("packaging_configuration_id"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_configuration_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_configuration_id",
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_hls(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagevod.ResourcePackagingConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_cmaf(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_cmaf(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.ad_markers", "SCTE35_ENHANCED"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.stream_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.stream_selection.0.stream_order", "VIDEO_BITRATE_DESCENDING"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_configuration_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_configuration_id",
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_dash(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_dash(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.period_triggers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.period_triggers.0", "ADS"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.segment_template_format", "NUMBER_WITH_TIMELINE"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.0.manifest_layout", "COMPACT"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.0.min_buffer_time_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_configuration_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_configuration_id",
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_mss(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_mss(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.segment_duration_seconds", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.mss_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.mss_manifest.0.stream_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.mss_manifest.0.stream_selection.0.max_video_bits_per_second", "2000000"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_configuration_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_configuration_id",
+			},
+		},
+	})
+}
+
+func testAccCheckPackagingConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagevod_packaging_configuration" {
+				continue
+			}
+
+			_, err := tfmediapackagevod.FindPackagingConfigurationByID(ctx, conn, rs.Primary.Attributes["packaging_configuration_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage VOD Packaging Configuration %s still exists", rs.Primary.Attributes["packaging_configuration_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackagingConfigurationExists(ctx context.Context, n string, v *mediapackagevod.DescribePackagingConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		output, err := tfmediapackagevod.FindPackagingConfigurationByID(ctx, conn, rs.Primary.Attributes["packaging_configuration_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPackagingConfigurationConfig_hls(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  hls_package {
+    hls_manifest {}
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_cmaf(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  cmaf_package {
+    segment_duration_seconds = 6
+
+    hls_manifest {
+      ad_markers    = "SCTE35_ENHANCED"
+      manifest_name = "index"
+
+      stream_selection {
+        stream_order = "VIDEO_BITRATE_DESCENDING"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_dash(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  dash_package {
+    period_triggers         = ["ADS"]
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+
+    dash_manifest {
+      manifest_layout         = "COMPACT"
+      min_buffer_time_seconds = 30
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_mss(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  mss_package {
+    segment_duration_seconds = 2
+
+    mss_manifest {
+      stream_selection {
+        max_video_bits_per_second = 2000000
+      }
+    }
+  }
+}
+`, rName))
+}
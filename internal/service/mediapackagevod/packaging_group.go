@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagevod/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_media_packagevod_packaging_group", name="Packaging Group")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("packaging_group_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediapackagevod;mediapackagevod.DescribePackagingGroupOutput")
+// @Testing(importStateIdAttribute="packaging_group_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newPackagingGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &packagingGroupResource{}, nil
+}
+
+type packagingGroupResource struct {
+	framework.ResourceWithModel[packagingGroupResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *packagingGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDomainName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"packaging_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"authorization": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[authorizationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cdn_identifier_secret": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"secrets_role_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"egress_access_logs": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressAccessLogsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrLogGroupName: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^/aws/MediaPackage/`), "must start with /aws/MediaPackage/"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *packagingGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data packagingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.PackagingGroupID)
+	var input mediapackagevod.CreatePackagingGroupInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("PackagingGroup"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePackagingGroup(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage VOD Packaging Group (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("PackagingGroup"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *packagingGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data packagingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.PackagingGroupID)
+	output, err := findPackagingGroupByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage VOD Packaging Group (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("PackagingGroup"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *packagingGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old packagingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.PackagingGroupID)
+
+	if !new.Authorization.Equal(old.Authorization) {
+		input := mediapackagevod.UpdatePackagingGroupInput{
+			Id: aws.String(id),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.Authorization, &input.Authorization)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePackagingGroup(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaPackage VOD Packaging Group (%s) authorization", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.EgressAccessLogs.Equal(old.EgressAccessLogs) {
+		input := mediapackagevod.ConfigureLogsInput{
+			Id: aws.String(id),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.EgressAccessLogs, &input.EgressAccessLogs)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.ConfigureLogs(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("configuring MediaPackage VOD Packaging Group (%s) logs", id), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findPackagingGroupByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage VOD Packaging Group (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, fwflex.WithFieldNamePrefix("PackagingGroup"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *packagingGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data packagingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.PackagingGroupID)
+	tflog.Debug(ctx, "deleting MediaPackage VOD Packaging Group", map[string]any{
+		"packaging_group_id": id,
+	})
+	input := mediapackagevod.DeletePackagingGroupInput{
+		Id: aws.String(id),
+	}
+	_, err := conn.DeletePackagingGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage VOD Packaging Group (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := tfresource.RetryUntilNotFound(ctx, 5*time.Minute, func(ctx context.Context) (any, error) {
+		return findPackagingGroupByID(ctx, conn, id)
+	}); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaPackage VOD Packaging Group (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func findPackagingGroupByID(ctx context.Context, conn *mediapackagevod.Client, id string) (*mediapackagevod.DescribePackagingGroupOutput, error) {
+	input := mediapackagevod.DescribePackagingGroupInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribePackagingGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Id == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type packagingGroupResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                           `tfsdk:"arn"`
+	Authorization    fwtypes.ListNestedObjectValueOf[authorizationModel]    `tfsdk:"authorization"`
+	DomainName       types.String                                           `tfsdk:"domain_name"`
+	EgressAccessLogs fwtypes.ListNestedObjectValueOf[egressAccessLogsModel] `tfsdk:"egress_access_logs"`
+	PackagingGroupID types.String                                           `tfsdk:"packaging_group_id"`
+	Tags             tftags.Map                                             `tfsdk:"tags"`
+	TagsAll          tftags.Map                                             `tfsdk:"tags_all"`
+}
+
+type authorizationModel struct {
+	CDNIdentifierSecret fwtypes.ARN `tfsdk:"cdn_identifier_secret"`
+	SecretsRoleARN      fwtypes.ARN `tfsdk:"secrets_role_arn"`
+}
+
+type egressAccessLogsModel struct {
+	LogGroupName types.String `tfsdk:"log_group_name"`
+}
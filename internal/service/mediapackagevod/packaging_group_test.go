@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagevod "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageVODPackagingGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("mediapackage-vod", "packaging-groups/"+rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("authorization"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDomainName), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("packaging_group_id"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_group_id",
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagevod.ResourcePackagingGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_egressAccessLogs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_egressAccessLogs(rName, "/aws/MediaPackage/"+rName+"-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("egress_access_logs"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrLogGroupName: knownvalue.StringExact("/aws/MediaPackage/" + rName + "-1"),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_group_id",
+			},
+			{
+				Config: testAccPackagingGroupConfig_egressAccessLogs(rName, "/aws/MediaPackage/"+rName+"-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("egress_access_logs"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrLogGroupName: knownvalue.StringExact("/aws/MediaPackage/" + rName + "-2"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_authorization(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_authorization(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorization.0.cdn_identifier_secret", "aws_secretsmanager_secret.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "authorization.0.secrets_role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "packaging_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "packaging_group_id",
+			},
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackagingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagevod_packaging_group" {
+				continue
+			}
+
+			_, err := tfmediapackagevod.FindPackagingGroupByID(ctx, conn, rs.Primary.Attributes["packaging_group_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage VOD Packaging Group %s still exists", rs.Primary.Attributes["packaging_group_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackagingGroupExists(ctx context.Context, n string, v *mediapackagevod.DescribePackagingGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		output, err := tfmediapackagevod.FindPackagingGroupByID(ctx, conn, rs.Primary.Attributes["packaging_group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPackagingGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_packagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+}
+`, rName)
+}
+
+func testAccPackagingGroupConfig_egressAccessLogs(rName, logGroupName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_packagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+
+  egress_access_logs {
+    log_group_name = %[2]q
+  }
+}
+`, rName, logGroupName)
+}
+
+func testAccPackagingGroupConfig_authorization(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ MediaPackageCDNIdentifier = "cdn-identifier" })
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediapackage.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:ListSecretVersionIds",
+        "secretsmanager:ListSecrets",
+      ]
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+
+resource "aws_media_packagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+
+  authorization {
+    cdn_identifier_secret = aws_secretsmanager_secret.test.arn
+    secrets_role_arn      = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`, rName)
+}
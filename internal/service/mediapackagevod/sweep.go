@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_media_packagevod_asset", sweepAssets)
+	awsv2.Register("aws_media_packagevod_packaging_configuration", sweepPackagingConfigurations)
+	awsv2.Register("aws_media_packagevod_packaging_group", sweepPackagingGroups, "aws_media_packagevod_asset", "aws_media_packagevod_packaging_configuration")
+}
+
+func sweepAssets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaPackageVODClient(ctx)
+	var input mediapackagevod.ListAssetsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediapackagevod.NewListAssetsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Assets {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAssetResource, client,
+				framework.NewAttribute("asset_id", aws.ToString(v.Id))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPackagingConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaPackageVODClient(ctx)
+	var input mediapackagevod.ListPackagingConfigurationsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediapackagevod.NewListPackagingConfigurationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PackagingConfigurations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newPackagingConfigurationResource, client,
+				framework.NewAttribute("packaging_configuration_id", aws.ToString(v.Id))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPackagingGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaPackageVODClient(ctx)
+	var input mediapackagevod.ListPackagingGroupsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediapackagevod.NewListPackagingGroupsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PackagingGroups {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newPackagingGroupResource, client,
+				framework.NewAttribute("packaging_group_id", aws.ToString(v.Id))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  region = var.region
+
+  packaging_configuration_id = var.rName
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  hls_package {
+    hls_manifest {}
+  }
+}
+
+resource "aws_media_packagevod_packaging_group" "test" {
+  region = var.region
+
+  packaging_group_id = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
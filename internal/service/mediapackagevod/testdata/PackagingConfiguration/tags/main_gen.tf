@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  packaging_configuration_id = var.rName
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  hls_package {
+    hls_manifest {}
+  }
+
+  tags = var.resource_tags
+}
+
+resource "aws_media_packagevod_packaging_group" "test" {
+  packaging_group_id = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
@@ -0,0 +1,34 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "null" {}
+
+resource "aws_media_packagevod_packaging_configuration" "test" {
+  packaging_configuration_id = var.rName
+  packaging_group_id         = aws_media_packagevod_packaging_group.test.packaging_group_id
+
+  hls_package {
+    hls_manifest {}
+  }
+
+  tags = {
+    (var.unknownTagKey) = null_resource.test.id
+  }
+}
+
+resource "aws_media_packagevod_packaging_group" "test" {
+  packaging_group_id = var.rName
+}
+
+resource "null_resource" "test" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "unknownTagKey" {
+  type     = string
+  nullable = false
+}
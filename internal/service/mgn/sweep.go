@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_mgn_application", sweepApplications)
+	awsv2.Register("aws_mgn_launch_configuration_template", sweepLaunchConfigurationTemplates)
+	awsv2.Register("aws_mgn_replication_configuration_template", sweepReplicationConfigurationTemplates)
+	awsv2.Register("aws_mgn_wave", sweepWaves, "aws_mgn_application")
+}
+
+func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+	var input mgn.ListApplicationsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mgn.NewListApplicationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ApplicationID))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepLaunchConfigurationTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+	var input mgn.DescribeLaunchConfigurationTemplatesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mgn.NewDescribeLaunchConfigurationTemplatesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newLaunchConfigurationTemplateResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.LaunchConfigurationTemplateID))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepReplicationConfigurationTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+	var input mgn.DescribeReplicationConfigurationTemplatesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mgn.NewDescribeReplicationConfigurationTemplatesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newReplicationConfigurationTemplateResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ReplicationConfigurationTemplateID))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepWaves(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+	var input mgn.ListWavesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mgn.NewListWavesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newWaveResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WaveID))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
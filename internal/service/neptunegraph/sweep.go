@@ -36,8 +36,10 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 		for _, v := range page.Graphs {
 			id := aws.ToString(v.Id)
+			sweepable := framework.NewSweepResource(newGraphResource, client,
+				framework.NewAttribute(names.AttrID, id))
 
-			if aws.ToBool(v.DeletionProtection) {
+			if aws.ToBool(v.DeletionProtection) && !sweep.SkipResourceAction(ctx, sweep.Describe(ctx, sweepable), "disable deletion protection for Neptune Analytics Graph %s", id) {
 				input := neptunegraph.UpdateGraphInput{
 					DeletionProtection: aws.Bool(false),
 					GraphIdentifier:    aws.String(id),
@@ -55,8 +57,7 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 				}
 			}
 
-			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
-				framework.NewAttribute(names.AttrID, id)))
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -143,7 +143,10 @@ func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepa
 				}
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Tags:      keyValueTags(ctx, v.TagList).Map(),
+				CreatedAt: aws.ToTime(v.ClusterCreateTime),
+			}))
 		}
 	}
 
@@ -239,7 +242,11 @@ func sweepInstances(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 			d.Set(names.AttrIdentifier, v.DBInstanceIdentifier)
 			d.Set("skip_final_snapshot", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewDescribedSweepable(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Name:      aws.ToString(v.DBInstanceIdentifier),
+				Tags:      keyValueTags(ctx, v.TagList).Map(),
+				CreatedAt: aws.ToTime(v.InstanceCreateTime),
+			}))
 		}
 	}
 
@@ -457,6 +464,13 @@ func (s instanceAutomatedBackupSweeper) Delete(ctx context.Context, optFns ...tf
 	return nil
 }
 
+// Metadata describes the RDS Instance Automated Backup.
+func (s instanceAutomatedBackupSweeper) Metadata(ctx context.Context) sweep.Metadata {
+	return sweep.Metadata{
+		ID: s.backupARN,
+	}
+}
+
 func sweepShardGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RDSClient(ctx)
 	var input rds.DescribeDBShardGroupsInput
@@ -515,6 +529,13 @@ func newBlueGreenDeploymentSweeper(ctx context.Context, id string, client *conns
 	}
 }
 
+// Metadata describes the RDS Blue/Green Deployment.
+func (s blueGreenDeploymentSweeper) Metadata(ctx context.Context) sweep.Metadata {
+	return sweep.Metadata{
+		ID: s.id,
+	}
+}
+
 func (s blueGreenDeploymentSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	input := rds.DeleteBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(s.id),
@@ -4,24 +4,24 @@
 package schemas
 
 import (
+	"context"
 	// "fmt"
 	"log"
 	// "strings"
 
 	// "github.com/aws/aws-sdk-go-v2/aws"
 	// "github.com/aws/aws-sdk-go-v2/service/schemas"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	// "github.com/hashicorp/terraform-provider-aws/internal/sweep"
-	// "github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_schemas_discoverer", &awsv2.Sweeper{
 		Name: "aws_schemas_discoverer",
 		F:    sweepDiscoverers,
 	})
 
-	resource.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_schemas_registry", &awsv2.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepRegistries,
 		Dependencies: []string{
@@ -29,16 +29,15 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_schemas_schema", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_schemas_schema", &awsv2.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepSchemas,
 	})
 }
 
-func sweepDiscoverers(region string) error {
+func sweepDiscoverers(ctx context.Context, region string) error {
 	log.Printf("[WARN] Skipping EventBridge Schemas Discoverer sweep for %s", region)
 	/*
-		ctx := sweep.Context(region)
 		client, err := sweep.SharedRegionalSweepClient(ctx, region)
 		if err != nil {
 			return fmt.Errorf("getting client: %w", err)
@@ -78,10 +77,9 @@ func sweepDiscoverers(region string) error {
 	return nil
 }
 
-func sweepRegistries(region string) error {
+func sweepRegistries(ctx context.Context, region string) error {
 	log.Printf("[WARN] Skipping EventBridge Schemas Registry sweep for %s", region)
 	/*
-		ctx := sweep.Context(region)
 		client, err := sweep.SharedRegionalSweepClient(ctx, region)
 		if err != nil {
 			return fmt.Errorf("getting client: %w", err)
@@ -127,10 +125,9 @@ func sweepRegistries(region string) error {
 	return nil
 }
 
-func sweepSchemas(region string) error { // nosemgrep:ci.schemas-in-func-name
+func sweepSchemas(ctx context.Context, region string) error { // nosemgrep:ci.schemas-in-func-name
 	log.Printf("[WARN] Skipping EventBridge Schemas Schema sweep for %s", region)
 	/*
-		ctx := sweep.Context(region)
 		client, err := sweep.SharedRegionalSweepClient(ctx, region)
 		if err != nil {
 			return fmt.Errorf("getting client: %w", err)
@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,36 +13,38 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_configuration_set", &awsv2.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_domain_identity", &awsv2.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeDomain))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_email_identity", &awsv2.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeEmailAddress))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_receipt_rule_set", &awsv2.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
 }
 
-func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -64,6 +67,10 @@ func sweepConfigurationSets(region string) error {
 		for _, configurationSet := range output.ConfigurationSets {
 			name := aws.ToString(configurationSet.Name)
 
+			if sweep.SkipResourceAction(ctx, sweep.Metadata{ID: name}, "delete SES Configuration Set %s", name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
 			_, err := conn.DeleteConfigurationSet(ctx, &ses.DeleteConfigurationSetInput{
 				ConfigurationSetName: aws.String(name),
@@ -88,8 +95,7 @@ func sweepConfigurationSets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentities(region, identityType string) error {
-	ctx := sweep.Context(region)
+func sweepIdentities(ctx context.Context, region, identityType string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -109,6 +115,10 @@ func sweepIdentities(region, identityType string) error {
 		}
 
 		for _, identity := range output.Identities {
+			if sweep.SkipResourceAction(ctx, sweep.Metadata{ID: identity}, "delete SES Identity %s", identity) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Identity: %s", identity)
 			_, err = conn.DeleteIdentity(ctx, &ses.DeleteIdentityInput{
 				Identity: aws.String(identity),
@@ -133,8 +143,7 @@ func sweepIdentities(region, identityType string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepReceiptRuleSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepReceiptRuleSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -143,15 +152,31 @@ func sweepReceiptRuleSets(region string) error {
 
 	// You cannot delete the receipt rule set that is currently active.
 	// Setting the name of the active receipt rule set to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+	active, err := conn.DescribeActiveReceiptRuleSet(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
 	// In some regions, this will return "InvalidAction" with no message
 	if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "InvalidAction") {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("disabling any currently active SES Receipt Rule Set: %w", err)
+		return fmt.Errorf("reading active SES Receipt Rule Set: %w", err)
+	}
+
+	if active.Metadata != nil {
+		name := aws.ToString(active.Metadata.Name)
+		m := sweep.Metadata{
+			ID:        name,
+			CreatedAt: aws.ToTime(active.Metadata.CreatedTimestamp),
+		}
+
+		if !sweep.SkipResourceAction(ctx, m, "disable active SES Receipt Rule Set %s", name) {
+			log.Printf("[INFO] Disabling active SES Receipt Rule Set: %s", name)
+			_, err = conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+
+			if err != nil {
+				return fmt.Errorf("disabling active SES Receipt Rule Set (%s): %w", name, err)
+			}
+		}
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
@@ -171,6 +196,14 @@ func sweepReceiptRuleSets(region string) error {
 		for _, ruleSet := range output.RuleSets {
 			name := aws.ToString(ruleSet.Name)
 
+			m := sweep.Metadata{
+				ID:        name,
+				CreatedAt: aws.ToTime(ruleSet.CreatedTimestamp),
+			}
+			if sweep.SkipResourceAction(ctx, m, "delete SES Receipt Rule Set %s", name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
 			_, err := conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
 				RuleSetName: aws.String(name),
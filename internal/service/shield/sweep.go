@@ -4,12 +4,12 @@
 package shield
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &awsv2.Sweeper{
 		Name: "aws_shield_drt_access_log_bucket_association",
 		F:    sweepDRTAccessLogBucketAssociations,
 	})
 
-	resource.AddTestSweepers("aws_shield_drt_access_role_arn_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_shield_drt_access_role_arn_association", &awsv2.Sweeper{
 		Name: "aws_shield_drt_access_role_arn_association",
 		F:    sweepDRTAccessRoleARNAssociations,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_shield_proactive_engagement", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_shield_proactive_engagement", &awsv2.Sweeper{
 		Name: "aws_shield_proactive_engagement",
 		F:    sweepProactiveEngagements,
 		Dependencies: []string{
@@ -39,8 +39,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepDRTAccessLogBucketAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDRTAccessLogBucketAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -76,8 +75,7 @@ func sweepDRTAccessLogBucketAssociations(region string) error {
 	return nil
 }
 
-func sweepDRTAccessRoleARNAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDRTAccessRoleARNAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -113,8 +111,7 @@ func sweepDRTAccessRoleARNAssociations(region string) error {
 	return nil
 }
 
-func sweepProactiveEngagements(region string) error {
-	ctx := sweep.Context(region)
+func sweepProactiveEngagements(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -21,17 +20,17 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_default_patch_baseline", &awsv2.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_maintenance_window", &awsv2.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_patch_baseline", &awsv2.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepPatchBaselines,
 		Dependencies: []string{
@@ -40,19 +39,18 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_patch_group", &awsv2.Sweeper{
 		Name: "aws_ssm_patch_group",
 		F:    sweepPatchGroups,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_resource_data_sync", &awsv2.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
 }
 
-func sweepDefaultPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+func sweepDefaultPatchBaselines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -113,8 +111,7 @@ func (s defaultPatchBaselineSweeper) Delete(ctx context.Context, optFns ...tfres
 	return sdkdiag.DiagnosticsError(diags)
 }
 
-func sweepMaintenanceWindows(region string) error {
-	ctx := sweep.Context(region)
+func sweepMaintenanceWindows(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -154,8 +151,7 @@ func sweepMaintenanceWindows(region string) error {
 	return nil
 }
 
-func sweepPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+func sweepPatchBaselines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -195,8 +191,7 @@ func sweepPatchBaselines(region string) error {
 	return nil
 }
 
-func sweepPatchGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepPatchGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -236,8 +231,7 @@ func sweepPatchGroups(region string) error {
 	return nil
 }
 
-func sweepResourceDataSyncs(region string) error {
-	ctx := sweep.Context(region)
+func sweepResourceDataSyncs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -0,0 +1,605 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssmsap_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("application_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ssmsap/types;awstypes;awstypes.Application")
+// @Testing(importStateIdAttribute="application_id")
+// Testing requires a running SAP HANA or SAP ABAP system
+// @Testing(tagsTest=false, identityTest=false)
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithModel[applicationResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_registry_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w\d\.-]+$`), ""),
+				},
+			},
+			"application_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"components": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"database_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"discovery_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationDiscoveryStatus](),
+				Computed:   true,
+			},
+			"instances": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 1),
+				},
+			},
+			"sap_instance_number": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{2}$`), "must be a 2-digit instance number"),
+				},
+			},
+			"sid": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Z][A-Z0-9]{2}$`), "must be a 3-character SAP System ID"),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"component_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[componentInfoModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"component_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ComponentType](),
+							Required:   true,
+						},
+						"ec2_instance_id": schema.StringAttribute{
+							Required: true,
+						},
+						"sid": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Z][A-Z0-9]{2}$`), "must be a 3-character SAP System ID"),
+							},
+						},
+					},
+				},
+			},
+			"credential": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[applicationCredentialModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"credential_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CredentialType](),
+							Required:   true,
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"secret_id": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ApplicationID)
+	var input ssmsap.RegisterApplicationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.RegisterApplication(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("registering Systems Manager for SAP Application (%s)", id), err.Error())
+
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), createTimeout); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), id) // Set 'application_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) registration", id), err.Error())
+
+		return
+	}
+
+	app, err := waitApplicationDiscovered(ctx, conn, id, createTimeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), id) // Set 'application_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) discovery", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &data, fwflex.WithFieldNamePrefix("Application"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ApplicationID)
+	app, err := findApplicationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &data, fwflex.WithFieldNamePrefix("Application"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ApplicationID)
+	if !new.Credentials.Equal(old.Credentials) || !new.DatabaseARN.Equal(old.DatabaseARN) {
+		input := ssmsap.UpdateApplicationSettingsInput{
+			ApplicationId: aws.String(id),
+		}
+
+		if !new.Credentials.Equal(old.Credentials) {
+			var newCredentials, oldCredentials []awstypes.ApplicationCredential
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.Credentials, &newCredentials)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			response.Diagnostics.Append(fwflex.Expand(ctx, old.Credentials, &oldCredentials)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.CredentialsToAddOrUpdate, input.CredentialsToRemove = applicationCredentialsDiff(oldCredentials, newCredentials)
+		}
+
+		if !new.DatabaseARN.Equal(old.DatabaseARN) {
+			input.DatabaseArn = fwflex.StringFromFramework(ctx, new.DatabaseARN)
+		}
+
+		output, err := conn.UpdateApplicationSettings(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Systems Manager for SAP Application (%s) settings", id), err.Error())
+
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+		for _, operationID := range output.OperationIds {
+			if _, err := waitOperationSucceeded(ctx, conn, operationID, updateTimeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) settings update", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	app, err := findApplicationByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &new, fwflex.WithFieldNamePrefix("Application"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ApplicationID)
+	input := ssmsap.DeregisterApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+	_, err := conn.DeregisterApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deregistering Systems Manager for SAP Application (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) deregistration", id), err.Error())
+
+		return
+	}
+}
+
+// applicationCredentialsDiff returns the credentials to add or update and the credentials to remove.
+// Credentials are keyed on credential type and database name.
+func applicationCredentialsDiff(old, new []awstypes.ApplicationCredential) ([]awstypes.ApplicationCredential, []awstypes.ApplicationCredential) {
+	sameKey := func(a, b awstypes.ApplicationCredential) bool {
+		return a.CredentialType == b.CredentialType && aws.ToString(a.DatabaseName) == aws.ToString(b.DatabaseName)
+	}
+
+	var add, remove []awstypes.ApplicationCredential
+
+	for _, n := range new {
+		if !slices.ContainsFunc(old, func(o awstypes.ApplicationCredential) bool {
+			return sameKey(o, n) && aws.ToString(o.SecretId) == aws.ToString(n.SecretId)
+		}) {
+			add = append(add, n)
+		}
+	}
+
+	for _, o := range old {
+		if !slices.ContainsFunc(new, func(n awstypes.ApplicationCredential) bool {
+			return sameKey(o, n)
+		}) {
+			remove = append(remove, o)
+		}
+	}
+
+	return add, remove
+}
+
+func findApplicationByID(ctx context.Context, conn *ssmsap.Client, id string) (*awstypes.Application, error) {
+	input := ssmsap.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Application == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Application, nil
+}
+
+func findOperationByID(ctx context.Context, conn *ssmsap.Client, id string) (*awstypes.Operation, error) {
+	input := ssmsap.GetOperationInput{
+		OperationId: aws.String(id),
+	}
+
+	output, err := conn.GetOperation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Operation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Operation, nil
+}
+
+func statusApplication(ctx context.Context, conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusApplicationDiscovery(ctx context.Context, conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DiscoveryStatus), nil
+	}
+}
+
+func statusOperation(ctx context.Context, conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitOperationSucceeded(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Operation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.OperationStatusInprogress),
+		Target:  enum.Slice(awstypes.OperationStatusSuccess),
+		Refresh: statusOperation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Operation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDiscovered(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Application, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationDiscoveryStatusRegistering),
+		Target:  enum.Slice(awstypes.ApplicationDiscoveryStatusSuccess),
+		Refresh: statusApplicationDiscovery(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Application, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusActivated, awstypes.ApplicationStatusStopped, awstypes.ApplicationStatusDeleting),
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type applicationResourceModel struct {
+	framework.WithRegionModel
+	AppRegistryARN    types.String                                               `tfsdk:"app_registry_arn"`
+	ApplicationID     types.String                                               `tfsdk:"application_id"`
+	ApplicationType   fwtypes.StringEnum[awstypes.ApplicationType]               `tfsdk:"application_type"`
+	ARN               types.String                                               `tfsdk:"arn"`
+	Components        fwtypes.ListOfString                                       `tfsdk:"components"`
+	ComponentsInfo    fwtypes.ListNestedObjectValueOf[componentInfoModel]        `tfsdk:"component_info"`
+	Credentials       fwtypes.SetNestedObjectValueOf[applicationCredentialModel] `tfsdk:"credential"`
+	DatabaseARN       fwtypes.ARN                                                `tfsdk:"database_arn"`
+	DiscoveryStatus   fwtypes.StringEnum[awstypes.ApplicationDiscoveryStatus]    `tfsdk:"discovery_status"`
+	Instances         fwtypes.SetOfString                                        `tfsdk:"instances"`
+	SAPInstanceNumber types.String                                               `tfsdk:"sap_instance_number"`
+	SID               types.String                                               `tfsdk:"sid"`
+	Status            fwtypes.StringEnum[awstypes.ApplicationStatus]             `tfsdk:"status"`
+	Tags              tftags.Map                                                 `tfsdk:"tags"`
+	TagsAll           tftags.Map                                                 `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type componentInfoModel struct {
+	ComponentType fwtypes.StringEnum[awstypes.ComponentType] `tfsdk:"component_type"`
+	EC2InstanceID types.String                               `tfsdk:"ec2_instance_id"`
+	SID           types.String                               `tfsdk:"sid"`
+}
+
+type applicationCredentialModel struct {
+	CredentialType fwtypes.StringEnum[awstypes.CredentialType] `tfsdk:"credential_type"`
+	DatabaseName   types.String                                `tfsdk:"database_name"`
+	SecretID       types.String                                `tfsdk:"secret_id"`
+}
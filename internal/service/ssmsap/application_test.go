@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmsap "github.com/hashicorp/terraform-provider-aws/internal/service/ssmsap"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Registering an application requires an EC2 instance running SAP HANA with the
+// AWS Systems Manager for SAP prerequisites in place, so these tests only run when
+// details of such a system are supplied.
+const (
+	envVarHANAInstanceID     = "SSMSAP_HANA_INSTANCE_ID"
+	envVarHANAInstanceNumber = "SSMSAP_HANA_INSTANCE_NUMBER"
+	envVarHANASecretARN      = "SSMSAP_HANA_SECRET_ARN"
+	envVarHANASID            = "SSMSAP_HANA_SID"
+)
+
+type testAccHANASystem struct {
+	instanceID     string
+	instanceNumber string
+	secretARN      string
+	sid            string
+}
+
+func testAccHANASystemFromEnv(t *testing.T) testAccHANASystem {
+	t.Helper()
+
+	return testAccHANASystem{
+		instanceID:     acctest.SkipIfEnvVarNotSet(t, envVarHANAInstanceID),
+		instanceNumber: acctest.SkipIfEnvVarNotSet(t, envVarHANAInstanceNumber),
+		secretARN:      acctest.SkipIfEnvVarNotSet(t, envVarHANASecretARN),
+		sid:            acctest.SkipIfEnvVarNotSet(t, envVarHANASID),
+	}
+}
+
+func TestAccSSMSAPApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Application
+	hana := testAccHANASystemFromEnv(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMSAP)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, hana),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrApplicationID), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("application_type"), tfknownvalue.StringExact(awstypes.ApplicationTypeHana)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("ssm-sap", regexache.MustCompile(`.+/`+rName+`$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("components"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("discovery_status"), tfknownvalue.StringExact(awstypes.ApplicationDiscoveryStatusSuccess)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrApplicationID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrApplicationID,
+				ImportStateVerifyIgnore: []string{
+					"credential",
+					"instances",
+					"sap_instance_number",
+					"sid",
+				},
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Application
+	hana := testAccHANASystemFromEnv(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMSAP)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, hana),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssmsap.ResourceApplication, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Application
+	hana := testAccHANASystemFromEnv(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMSAP)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_tags1(rName, hana, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				Config: testAccApplicationConfig_tags2(rName, hana, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+			{
+				Config: testAccApplicationConfig_tags1(rName, hana, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmsap_application" {
+				continue
+			}
+
+			_, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Systems Manager for SAP Application %s still exists", rs.Primary.Attributes[names.AttrApplicationID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string, v *awstypes.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+		output, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccApplicationConfig_basic(rName string, hana testAccHANASystem) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[3]q
+  sid                 = %[4]q
+
+  credential {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[5]q
+  }
+}
+`, rName, hana.instanceID, hana.instanceNumber, hana.sid, hana.secretARN)
+}
+
+func testAccApplicationConfig_tags1(rName string, hana testAccHANASystem, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[3]q
+  sid                 = %[4]q
+
+  credential {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[5]q
+  }
+
+  tags = {
+    %[6]q = %[7]q
+  }
+}
+`, rName, hana.instanceID, hana.instanceNumber, hana.sid, hana.secretARN, tagKey1, tagValue1)
+}
+
+func testAccApplicationConfig_tags2(rName string, hana testAccHANASystem, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[3]q
+  sid                 = %[4]q
+
+  credential {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[5]q
+  }
+
+  tags = {
+    %[6]q = %[7]q
+    %[8]q = %[9]q
+  }
+}
+`, rName, hana.instanceID, hana.instanceNumber, hana.sid, hana.secretARN, tagKey1, tagValue1, tagKey2, tagValue2)
+}
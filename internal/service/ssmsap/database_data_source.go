@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssmsap_database", name="Database")
+// @Tags
+func newDatabaseDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &databaseDataSource{}, nil
+}
+
+type databaseDataSource struct {
+	framework.DataSourceWithModel[databaseDataSourceModel]
+}
+
+func (d *databaseDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"component_id": schema.StringAttribute{
+				Required: true,
+			},
+			"connected_component_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"credentials": framework.DataSourceComputedListOfObjectAttribute[applicationCredentialModel](ctx),
+			"database_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDatabaseName: schema.StringAttribute{
+				Computed: true,
+			},
+			"database_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatabaseType](),
+				Computed:   true,
+			},
+			"last_updated": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"primary_host": schema.StringAttribute{
+				Computed: true,
+			},
+			"sql_port": schema.Int32Attribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatabaseStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *databaseDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data databaseDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMSAPClient(ctx)
+
+	applicationID, componentID, databaseID := fwflex.StringValueFromFramework(ctx, data.ApplicationID), fwflex.StringValueFromFramework(ctx, data.ComponentID), fwflex.StringValueFromFramework(ctx, data.DatabaseID)
+	output, err := findDatabaseByThreePartKey(ctx, conn, applicationID, componentID, databaseID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Database (%s/%s/%s)", applicationID, componentID, databaseID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Database, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDatabaseByThreePartKey(ctx context.Context, conn *ssmsap.Client, applicationID, componentID, databaseID string) (*ssmsap.GetDatabaseOutput, error) {
+	input := ssmsap.GetDatabaseInput{
+		ApplicationId: aws.String(applicationID),
+		ComponentId:   aws.String(componentID),
+		DatabaseId:    aws.String(databaseID),
+	}
+
+	output, err := conn.GetDatabase(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Database == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type databaseDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationID          types.String                                                `tfsdk:"application_id"`
+	ARN                    types.String                                                `tfsdk:"arn"`
+	ComponentID            types.String                                                `tfsdk:"component_id"`
+	ConnectedComponentARNs fwtypes.ListOfString                                        `tfsdk:"connected_component_arns"`
+	Credentials            fwtypes.ListNestedObjectValueOf[applicationCredentialModel] `tfsdk:"credentials"`
+	DatabaseID             types.String                                                `tfsdk:"database_id"`
+	DatabaseName           types.String                                                `tfsdk:"database_name"`
+	DatabaseType           fwtypes.StringEnum[awstypes.DatabaseType]                   `tfsdk:"database_type"`
+	LastUpdated            timetypes.RFC3339                                           `tfsdk:"last_updated"`
+	PrimaryHost            types.String                                                `tfsdk:"primary_host"`
+	SQLPort                types.Int32                                                 `tfsdk:"sql_port"`
+	Status                 fwtypes.StringEnum[awstypes.DatabaseStatus]                 `tfsdk:"status"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+}
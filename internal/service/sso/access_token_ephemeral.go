@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssooidc/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	grantTypeDeviceCode   = "urn:ietf:params:oauth:grant-type:device_code"
+	grantTypeRefreshToken = "refresh_token"
+)
+
+// @EphemeralResource("aws_sso_access_token", name="Access Token")
+func newAccessTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &accessTokenEphemeralResource{}, nil
+}
+
+type accessTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[accessTokenEphemeralResourceModel]
+}
+
+func (e *accessTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_id": schema.StringAttribute{
+				Required: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"expires_in": schema.Int32Attribute{
+				Computed: true,
+			},
+			"id_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"new_refresh_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"refresh_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("start_url")),
+				},
+			},
+			"scopes": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"start_url": schema.StringAttribute{
+				Optional: true,
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *accessTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data accessTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSOOIDCClient(ctx)
+
+	input := ssooidc.CreateTokenInput{
+		ClientId:     fwflex.StringFromFramework(ctx, data.ClientID),
+		ClientSecret: fwflex.StringFromFramework(ctx, data.ClientSecret),
+		Scope:        fwflex.ExpandFrameworkStringValueList(ctx, data.Scopes),
+	}
+	var output *ssooidc.CreateTokenOutput
+
+	if data.StartURL.IsNull() {
+		input.GrantType = aws.String(grantTypeRefreshToken)
+		input.RefreshToken = fwflex.StringFromFramework(ctx, data.RefreshToken)
+
+		var err error
+		output, err = conn.CreateToken(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating SSO OIDC access token", err.Error())
+
+			return
+		}
+	} else {
+		startInput := ssooidc.StartDeviceAuthorizationInput{
+			ClientId:     fwflex.StringFromFramework(ctx, data.ClientID),
+			ClientSecret: fwflex.StringFromFramework(ctx, data.ClientSecret),
+			StartUrl:     fwflex.StringFromFramework(ctx, data.StartURL),
+		}
+
+		startOutput, err := conn.StartDeviceAuthorization(ctx, &startInput)
+
+		if err != nil {
+			response.Diagnostics.AddError("starting SSO OIDC device authorization", err.Error())
+
+			return
+		}
+
+		tflog.Warn(ctx, "Waiting for SSO OIDC device authorization; open the verification URI in a browser and approve the request", map[string]any{
+			"user_code":                 aws.ToString(startOutput.UserCode),
+			"verification_uri_complete": aws.ToString(startOutput.VerificationUriComplete),
+		})
+
+		input.DeviceCode = startOutput.DeviceCode
+		input.GrantType = aws.String(grantTypeDeviceCode)
+
+		output, err = createTokenWithDeviceCode(ctx, conn, &input, time.Duration(startOutput.ExpiresIn)*time.Second)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating SSO OIDC access token with device code", err.Error())
+
+			return
+		}
+	}
+
+	data.AccessToken = fwflex.StringToFramework(ctx, output.AccessToken)
+	data.ExpiresIn = types.Int32Value(output.ExpiresIn)
+	data.IDToken = fwflex.StringToFramework(ctx, output.IdToken)
+	data.NewRefreshToken = fwflex.StringToFramework(ctx, output.RefreshToken)
+	data.TokenType = fwflex.StringToFramework(ctx, output.TokenType)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// createTokenWithDeviceCode polls CreateToken until the user approves the device authorization request or it expires.
+func createTokenWithDeviceCode(ctx context.Context, conn *ssooidc.Client, input *ssooidc.CreateTokenInput, timeout time.Duration) (*ssooidc.CreateTokenOutput, error) {
+	outputRaw, err := tfresource.RetryWhenIsOneOf2[*awstypes.AuthorizationPendingException, *awstypes.SlowDownException](ctx, timeout, func() (any, error) {
+		return conn.CreateToken(ctx, input)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*ssooidc.CreateTokenOutput), nil
+}
+
+type accessTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessToken     types.String         `tfsdk:"access_token"`
+	ClientID        types.String         `tfsdk:"client_id"`
+	ClientSecret    types.String         `tfsdk:"client_secret"`
+	ExpiresIn       types.Int32          `tfsdk:"expires_in"`
+	IDToken         types.String         `tfsdk:"id_token"`
+	NewRefreshToken types.String         `tfsdk:"new_refresh_token"`
+	RefreshToken    types.String         `tfsdk:"refresh_token"`
+	Scopes          fwtypes.ListOfString `tfsdk:"scopes"`
+	StartURL        types.String         `tfsdk:"start_url"`
+	TokenType       types.String         `tfsdk:"token_type"`
+}
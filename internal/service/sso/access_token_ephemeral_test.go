@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// A registered OIDC client and a refresh token issued to it, for example from the `aws sso login` cache.
+const (
+	envVarOIDCClientID     = "AWS_SSO_OIDC_CLIENT_ID"
+	envVarOIDCClientSecret = "AWS_SSO_OIDC_CLIENT_SECRET"
+	envVarOIDCRefreshToken = "AWS_SSO_OIDC_REFRESH_TOKEN"
+)
+
+func TestAccSSOAccessTokenEphemeral_refreshToken(t *testing.T) {
+	ctx := acctest.Context(t)
+	clientID := acctest.SkipIfEnvVarNotSet(t, envVarOIDCClientID)
+	clientSecret := acctest.SkipIfEnvVarNotSet(t, envVarOIDCClientSecret)
+	refreshToken := acctest.SkipIfEnvVarNotSet(t, envVarOIDCRefreshToken)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.SSOServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessTokenEphemeralConfig_refreshToken(clientID, clientSecret, refreshToken),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("new_refresh_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("refresh_token"), knownvalue.StringExact(refreshToken)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSSOAccessTokenEphemeral_startURLAndRefreshTokenConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.SSOServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessTokenEphemeralConfig_startURLAndRefreshToken(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccAccessTokenEphemeralConfig_refreshToken(clientID, clientSecret, refreshToken string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sso_access_token.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sso_access_token" "test" {
+  client_id     = %[1]q
+  client_secret = %[2]q
+  refresh_token = %[3]q
+}
+`, clientID, clientSecret, refreshToken))
+}
+
+func testAccAccessTokenEphemeralConfig_startURLAndRefreshToken() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sso_access_token.test"),
+		`
+ephemeral "aws_sso_access_token" "test" {
+  client_id     = "test"
+  client_secret = "test"
+  refresh_token = "test"
+  start_url     = "https://example.awsapps.com/start"
+}
+`)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sso_account_roles", name="Account Roles")
+func newAccountRolesEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &accountRolesEphemeralResource{}, nil
+}
+
+type accountRolesEphemeralResource struct {
+	framework.EphemeralResourceWithModel[accountRolesEphemeralResourceModel]
+}
+
+func (e *accountRolesEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"roles": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[roleModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[roleModel](ctx),
+				},
+			},
+		},
+	}
+}
+
+func (e *accountRolesEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data accountRolesEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSOClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	input := sso.ListAccountRolesInput{
+		AccessToken: fwflex.StringFromFramework(ctx, data.AccessToken),
+		AccountId:   fwflex.StringFromFramework(ctx, data.AccountID),
+	}
+	output, err := findAccountRoles(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing SSO account (%s) roles", accountID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Roles)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+func findAccountRoles(ctx context.Context, conn *sso.Client, input *sso.ListAccountRolesInput) ([]awstypes.RoleInfo, error) {
+	var output []awstypes.RoleInfo
+
+	pages := sso.NewListAccountRolesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RoleList...)
+	}
+
+	return output, nil
+}
+
+type accountRolesEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessToken types.String                               `tfsdk:"access_token"`
+	AccountID   types.String                               `tfsdk:"account_id"`
+	Roles       fwtypes.ListNestedObjectValueOf[roleModel] `tfsdk:"roles"`
+}
+
+type roleModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	RoleName  types.String `tfsdk:"role_name"`
+}
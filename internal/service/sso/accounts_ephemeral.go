@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @EphemeralResource("aws_sso_accounts", name="Accounts")
+func newAccountsEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &accountsEphemeralResource{}, nil
+}
+
+type accountsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[accountsEphemeralResourceModel]
+}
+
+func (e *accountsEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"accounts": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accountModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[accountModel](ctx),
+				},
+			},
+		},
+	}
+}
+
+func (e *accountsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data accountsEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSOClient(ctx)
+
+	input := sso.ListAccountsInput{
+		AccessToken: fwflex.StringFromFramework(ctx, data.AccessToken),
+	}
+	output, err := findAccounts(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing SSO accounts", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Accounts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+func findAccounts(ctx context.Context, conn *sso.Client, input *sso.ListAccountsInput) ([]awstypes.AccountInfo, error) {
+	var output []awstypes.AccountInfo
+
+	pages := sso.NewListAccountsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountList...)
+	}
+
+	return output, nil
+}
+
+type accountsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessToken types.String                                  `tfsdk:"access_token"`
+	Accounts    fwtypes.ListNestedObjectValueOf[accountModel] `tfsdk:"accounts"`
+}
+
+type accountModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	AccountName  types.String `tfsdk:"account_name"`
+	EmailAddress types.String `tfsdk:"email_address"`
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// envVarAccessToken is an IAM Identity Center access token for a workforce user,
+// such as the one cached by `aws sso login`.
+const envVarAccessToken = "AWS_SSO_ACCESS_TOKEN"
+
+func testAccAccessTokenFromEnv(t *testing.T) string {
+	t.Helper()
+
+	return acctest.SkipIfEnvVarNotSet(t, envVarAccessToken)
+}
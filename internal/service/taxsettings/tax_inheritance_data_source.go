@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_taxsettings_tax_inheritance", name="Tax Inheritance")
+func newTaxInheritanceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &taxInheritanceDataSource{}, nil
+}
+
+type taxInheritanceDataSource struct {
+	framework.DataSourceWithModel[taxInheritanceDataSourceModel]
+}
+
+func (d *taxInheritanceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"heritage_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.HeritageStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *taxInheritanceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data taxInheritanceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().TaxSettingsClient(ctx)
+
+	var input taxsettings.GetTaxInheritanceInput
+	output, err := conn.GetTaxInheritance(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Tax Settings Tax Inheritance", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type taxInheritanceDataSourceModel struct {
+	HeritageStatus fwtypes.StringEnum[awstypes.HeritageStatus] `tfsdk:"heritage_status"`
+}
@@ -0,0 +1,589 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_tax_registration", name="Tax Registration")
+func newTaxRegistrationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &taxRegistrationResource{}, nil
+}
+
+type taxRegistrationResource struct {
+	framework.ResourceWithModel[taxRegistrationResourceModel]
+}
+
+func (r *taxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	additionalInfoBlock := func(customType basetypes.ListTypable, attributes map[string]schema.Attribute) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: customType,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+			},
+		}
+	}
+	optionalString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+		}
+	}
+	personTypeAttributes := map[string]schema.Attribute{
+		"person_type": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.PersonType](),
+			Required:   true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certified_email_id": optionalString(),
+			"legal_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registration_id": schema.StringAttribute{
+				Required: true,
+			},
+			"registration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationType](),
+				Required:   true,
+			},
+			"sector": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Sector](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"additional_tax_information": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[additionalInfoModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"canada_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[canadaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"canada_quebec_sales_tax_number": optionalString(),
+							"canada_retail_sales_tax_number": optionalString(),
+							"is_reseller_account": schema.BoolAttribute{
+								Optional: true,
+							},
+							"provincial_sales_tax_id": optionalString(),
+						}),
+						"egypt_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[egyptAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"unique_identification_number":                 optionalString(),
+							"unique_identification_number_expiration_date": optionalString(),
+						}),
+						"estonia_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[estoniaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"registry_commercial_code": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"georgia_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[personTypeAdditionalInfoModel](ctx), personTypeAttributes),
+						"greece_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[greeceAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"contracting_authority_code": optionalString(),
+						}),
+						"indonesia_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[indonesiaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"decision_number":                optionalString(),
+							"ppn_exception_designation_code": optionalString(),
+							"tax_registration_number_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.IndonesiaTaxRegistrationNumberType](),
+								Optional:   true,
+							},
+						}),
+						"israel_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[israelAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"customer_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.IsraelCustomerType](),
+								Required:   true,
+							},
+							"dealer_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.IsraelDealerType](),
+								Required:   true,
+							},
+						}),
+						"italy_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[italyAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"cig_number":     optionalString(),
+							"cup_number":     optionalString(),
+							"sdi_account_id": optionalString(),
+							"tax_code":       optionalString(),
+						}),
+						"kenya_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[personTypeAdditionalInfoModel](ctx), personTypeAttributes),
+						"malaysia_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[malaysiaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"business_registration_number": optionalString(),
+							"service_tax_codes": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringEnumType[awstypes.MalaysiaServiceTaxCode](),
+								ElementType: fwtypes.StringEnumType[awstypes.MalaysiaServiceTaxCode](),
+								Optional:    true,
+							},
+							"tax_information_number": optionalString(),
+						}),
+						"poland_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[polandAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"individual_registration_number": optionalString(),
+							"is_group_vat_enabled": schema.BoolAttribute{
+								Optional: true,
+							},
+						}),
+						"romania_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[romaniaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"tax_registration_number_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationNumberType](),
+								Required:   true,
+							},
+						}),
+						"saudi_arabia_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[saudiArabiaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"tax_registration_number_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.SaudiArabiaTaxRegistrationNumberType](),
+								Optional:   true,
+							},
+						}),
+						"south_korea_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[southKoreaAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"business_representative_name": schema.StringAttribute{
+								Required: true,
+							},
+							"item_of_business": schema.StringAttribute{
+								Required: true,
+							},
+							"line_of_business": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"spain_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[spainAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"registration_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RegistrationType](),
+								Required:   true,
+							},
+						}),
+						"turkey_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[turkeyAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"industries": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.Industries](),
+								Optional:   true,
+							},
+							"kep_email_id":     optionalString(),
+							"secondary_tax_id": optionalString(),
+							"tax_office":       optionalString(),
+						}),
+						"ukraine_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[ukraineAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"ukraine_trn_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.UkraineTrnType](),
+								Required:   true,
+							},
+						}),
+						"uzbekistan_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[uzbekistanAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"tax_registration_number_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.UzbekistanTaxRegistrationNumberType](),
+								Optional:   true,
+							},
+							"vat_registration_number": optionalString(),
+						}),
+						"vietnam_additional_info": additionalInfoBlock(fwtypes.NewListNestedObjectTypeOf[vietnamAdditionalInfoModel](ctx), map[string]schema.Attribute{
+							"electronic_transaction_code_number": optionalString(),
+							"enterprise_identification_number":   optionalString(),
+							"payment_voucher_number":             optionalString(),
+							"payment_voucher_number_date":        optionalString(),
+						}),
+					},
+				},
+			},
+			"legal_address": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address_line_1": schema.StringAttribute{
+							Required: true,
+						},
+						"address_line_2": optionalString(),
+						"address_line_3": optionalString(),
+						"city": schema.StringAttribute{
+							Required: true,
+						},
+						"country_code": schema.StringAttribute{
+							Required: true,
+						},
+						"district_or_county": optionalString(),
+						"postal_code": schema.StringAttribute{
+							Required: true,
+						},
+						"state_or_region": optionalString(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *taxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data taxRegistrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.AccountID.IsUnknown() {
+		data.AccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	if err := putTaxRegistration(ctx, conn, &data); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *taxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data taxRegistrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *taxRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new taxRegistrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, new.AccountID)
+	if err := putTaxRegistration(ctx, conn, &new); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *taxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data taxRegistrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	tflog.Debug(ctx, "deleting Tax Settings Tax Registration", map[string]any{
+		names.AttrAccountID: accountID,
+	})
+
+	input := taxsettings.DeleteTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	_, err := conn.DeleteTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+}
+
+func (r *taxRegistrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrAccountID), request, response)
+}
+
+func putTaxRegistration(ctx context.Context, conn *taxsettings.Client, data *taxRegistrationResourceModel) error {
+	var entry awstypes.TaxRegistrationEntry
+	if diags := fwflex.Expand(ctx, data, &entry); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	input := taxsettings.PutTaxRegistrationInput{
+		AccountId:            fwflex.StringFromFramework(ctx, data.AccountID),
+		TaxRegistrationEntry: &entry,
+	}
+	_, err := conn.PutTaxRegistration(ctx, &input)
+
+	return err
+}
+
+func findTaxRegistrationByAccountID(ctx context.Context, conn *taxsettings.Client, accountID string) (*awstypes.TaxRegistration, error) {
+	input := taxsettings.GetTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	output, err := conn.GetTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TaxRegistration == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if status := output.TaxRegistration.Status; status == awstypes.TaxRegistrationStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		}
+	}
+
+	return output.TaxRegistration, nil
+}
+
+type taxRegistrationResourceModel struct {
+	AccountID                types.String                                         `tfsdk:"account_id" autoflex:"-"`
+	AdditionalTaxInformation fwtypes.ListNestedObjectValueOf[additionalInfoModel] `tfsdk:"additional_tax_information"`
+	CertifiedEmailID         types.String                                         `tfsdk:"certified_email_id"`
+	LegalAddress             fwtypes.ListNestedObjectValueOf[addressModel]        `tfsdk:"legal_address"`
+	LegalName                types.String                                         `tfsdk:"legal_name"`
+	RegistrationID           types.String                                         `tfsdk:"registration_id"`
+	RegistrationType         fwtypes.StringEnum[awstypes.TaxRegistrationType]     `tfsdk:"registration_type"`
+	Sector                   fwtypes.StringEnum[awstypes.Sector]                  `tfsdk:"sector"`
+	Status                   fwtypes.StringEnum[awstypes.TaxRegistrationStatus]   `tfsdk:"status"`
+}
+
+type addressModel struct {
+	AddressLine1     types.String `tfsdk:"address_line_1"`
+	AddressLine2     types.String `tfsdk:"address_line_2"`
+	AddressLine3     types.String `tfsdk:"address_line_3"`
+	City             types.String `tfsdk:"city"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	DistrictOrCounty types.String `tfsdk:"district_or_county"`
+	PostalCode       types.String `tfsdk:"postal_code"`
+	StateOrRegion    types.String `tfsdk:"state_or_region"`
+}
+
+type additionalInfoModel struct {
+	CanadaAdditionalInfo      fwtypes.ListNestedObjectValueOf[canadaAdditionalInfoModel]      `tfsdk:"canada_additional_info"`
+	EgyptAdditionalInfo       fwtypes.ListNestedObjectValueOf[egyptAdditionalInfoModel]       `tfsdk:"egypt_additional_info"`
+	EstoniaAdditionalInfo     fwtypes.ListNestedObjectValueOf[estoniaAdditionalInfoModel]     `tfsdk:"estonia_additional_info"`
+	GeorgiaAdditionalInfo     fwtypes.ListNestedObjectValueOf[personTypeAdditionalInfoModel]  `tfsdk:"georgia_additional_info"`
+	GreeceAdditionalInfo      fwtypes.ListNestedObjectValueOf[greeceAdditionalInfoModel]      `tfsdk:"greece_additional_info"`
+	IndonesiaAdditionalInfo   fwtypes.ListNestedObjectValueOf[indonesiaAdditionalInfoModel]   `tfsdk:"indonesia_additional_info"`
+	IsraelAdditionalInfo      fwtypes.ListNestedObjectValueOf[israelAdditionalInfoModel]      `tfsdk:"israel_additional_info"`
+	ItalyAdditionalInfo       fwtypes.ListNestedObjectValueOf[italyAdditionalInfoModel]       `tfsdk:"italy_additional_info"`
+	KenyaAdditionalInfo       fwtypes.ListNestedObjectValueOf[personTypeAdditionalInfoModel]  `tfsdk:"kenya_additional_info"`
+	MalaysiaAdditionalInfo    fwtypes.ListNestedObjectValueOf[malaysiaAdditionalInfoModel]    `tfsdk:"malaysia_additional_info"`
+	PolandAdditionalInfo      fwtypes.ListNestedObjectValueOf[polandAdditionalInfoModel]      `tfsdk:"poland_additional_info"`
+	RomaniaAdditionalInfo     fwtypes.ListNestedObjectValueOf[romaniaAdditionalInfoModel]     `tfsdk:"romania_additional_info"`
+	SaudiArabiaAdditionalInfo fwtypes.ListNestedObjectValueOf[saudiArabiaAdditionalInfoModel] `tfsdk:"saudi_arabia_additional_info"`
+	SouthKoreaAdditionalInfo  fwtypes.ListNestedObjectValueOf[southKoreaAdditionalInfoModel]  `tfsdk:"south_korea_additional_info"`
+	SpainAdditionalInfo       fwtypes.ListNestedObjectValueOf[spainAdditionalInfoModel]       `tfsdk:"spain_additional_info"`
+	TurkeyAdditionalInfo      fwtypes.ListNestedObjectValueOf[turkeyAdditionalInfoModel]      `tfsdk:"turkey_additional_info"`
+	UkraineAdditionalInfo     fwtypes.ListNestedObjectValueOf[ukraineAdditionalInfoModel]     `tfsdk:"ukraine_additional_info"`
+	UzbekistanAdditionalInfo  fwtypes.ListNestedObjectValueOf[uzbekistanAdditionalInfoModel]  `tfsdk:"uzbekistan_additional_info"`
+	VietnamAdditionalInfo     fwtypes.ListNestedObjectValueOf[vietnamAdditionalInfoModel]     `tfsdk:"vietnam_additional_info"`
+}
+
+type canadaAdditionalInfoModel struct {
+	CanadaQuebecSalesTaxNumber types.String `tfsdk:"canada_quebec_sales_tax_number"`
+	CanadaRetailSalesTaxNumber types.String `tfsdk:"canada_retail_sales_tax_number"`
+	IsResellerAccount          types.Bool   `tfsdk:"is_reseller_account"`
+	ProvincialSalesTaxID       types.String `tfsdk:"provincial_sales_tax_id"`
+}
+
+type egyptAdditionalInfoModel struct {
+	UniqueIdentificationNumber               types.String `tfsdk:"unique_identification_number"`
+	UniqueIdentificationNumberExpirationDate types.String `tfsdk:"unique_identification_number_expiration_date"`
+}
+
+type estoniaAdditionalInfoModel struct {
+	RegistryCommercialCode types.String `tfsdk:"registry_commercial_code"`
+}
+
+// personTypeAdditionalInfoModel is shared by the Georgia and Kenya additional info.
+type personTypeAdditionalInfoModel struct {
+	PersonType fwtypes.StringEnum[awstypes.PersonType] `tfsdk:"person_type"`
+}
+
+type greeceAdditionalInfoModel struct {
+	ContractingAuthorityCode types.String `tfsdk:"contracting_authority_code"`
+}
+
+type indonesiaAdditionalInfoModel struct {
+	DecisionNumber              types.String                                                    `tfsdk:"decision_number"`
+	PpnExceptionDesignationCode types.String                                                    `tfsdk:"ppn_exception_designation_code"`
+	TaxRegistrationNumberType   fwtypes.StringEnum[awstypes.IndonesiaTaxRegistrationNumberType] `tfsdk:"tax_registration_number_type"`
+}
+
+type israelAdditionalInfoModel struct {
+	CustomerType fwtypes.StringEnum[awstypes.IsraelCustomerType] `tfsdk:"customer_type"`
+	DealerType   fwtypes.StringEnum[awstypes.IsraelDealerType]   `tfsdk:"dealer_type"`
+}
+
+type italyAdditionalInfoModel struct {
+	CigNumber    types.String `tfsdk:"cig_number"`
+	CupNumber    types.String `tfsdk:"cup_number"`
+	SdiAccountID types.String `tfsdk:"sdi_account_id"`
+	TaxCode      types.String `tfsdk:"tax_code"`
+}
+
+type malaysiaAdditionalInfoModel struct {
+	BusinessRegistrationNumber types.String                                             `tfsdk:"business_registration_number"`
+	ServiceTaxCodes            fwtypes.SetOfStringEnum[awstypes.MalaysiaServiceTaxCode] `tfsdk:"service_tax_codes"`
+	TaxInformationNumber       types.String                                             `tfsdk:"tax_information_number"`
+}
+
+type polandAdditionalInfoModel struct {
+	IndividualRegistrationNumber types.String `tfsdk:"individual_registration_number"`
+	IsGroupVatEnabled            types.Bool   `tfsdk:"is_group_vat_enabled"`
+}
+
+type romaniaAdditionalInfoModel struct {
+	TaxRegistrationNumberType fwtypes.StringEnum[awstypes.TaxRegistrationNumberType] `tfsdk:"tax_registration_number_type"`
+}
+
+type saudiArabiaAdditionalInfoModel struct {
+	TaxRegistrationNumberType fwtypes.StringEnum[awstypes.SaudiArabiaTaxRegistrationNumberType] `tfsdk:"tax_registration_number_type"`
+}
+
+type southKoreaAdditionalInfoModel struct {
+	BusinessRepresentativeName types.String `tfsdk:"business_representative_name"`
+	ItemOfBusiness             types.String `tfsdk:"item_of_business"`
+	LineOfBusiness             types.String `tfsdk:"line_of_business"`
+}
+
+type spainAdditionalInfoModel struct {
+	RegistrationType fwtypes.StringEnum[awstypes.RegistrationType] `tfsdk:"registration_type"`
+}
+
+type turkeyAdditionalInfoModel struct {
+	Industries     fwtypes.StringEnum[awstypes.Industries] `tfsdk:"industries"`
+	KepEmailID     types.String                            `tfsdk:"kep_email_id"`
+	SecondaryTaxID types.String                            `tfsdk:"secondary_tax_id"`
+	TaxOffice      types.String                            `tfsdk:"tax_office"`
+}
+
+type ukraineAdditionalInfoModel struct {
+	UkraineTrnType fwtypes.StringEnum[awstypes.UkraineTrnType] `tfsdk:"ukraine_trn_type"`
+}
+
+type uzbekistanAdditionalInfoModel struct {
+	TaxRegistrationNumberType fwtypes.StringEnum[awstypes.UzbekistanTaxRegistrationNumberType] `tfsdk:"tax_registration_number_type"`
+	VatRegistrationNumber     types.String                                                     `tfsdk:"vat_registration_number"`
+}
+
+type vietnamAdditionalInfoModel struct {
+	ElectronicTransactionCodeNumber types.String `tfsdk:"electronic_transaction_code_number"`
+	EnterpriseIdentificationNumber  types.String `tfsdk:"enterprise_identification_number"`
+	PaymentVoucherNumber            types.String `tfsdk:"payment_voucher_number"`
+	PaymentVoucherNumberDate        types.String `tfsdk:"payment_voucher_number_date"`
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccTaxSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"TaxInheritanceDataSource": {
+			acctest.CtBasic: testAccTaxInheritanceDataSource_basic,
+		},
+		"TaxRegistration": {
+			acctest.CtBasic:      testAccTaxRegistration_basic,
+			acctest.CtDisappears: testAccTaxRegistration_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
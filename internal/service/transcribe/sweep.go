@@ -4,18 +4,18 @@
 package transcribe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_language_model", &awsv2.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_medical_vocabulary", &awsv2.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_vocabulary", &awsv2.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_vocabulary_filter", &awsv2.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
@@ -49,8 +49,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepLanguageModels(region string) error {
-	ctx := sweep.Context(region)
+func sweepLanguageModels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -93,8 +92,7 @@ func sweepLanguageModels(region string) error {
 	return nil
 }
 
-func sweepMedicalVocabularies(region string) error {
-	ctx := sweep.Context(region)
+func sweepMedicalVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -138,8 +136,7 @@ func sweepMedicalVocabularies(region string) error {
 	return nil
 }
 
-func sweepVocabularies(region string) error {
-	ctx := sweep.Context(region)
+func sweepVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -183,8 +180,7 @@ func sweepVocabularies(region string) error {
 	return nil
 }
 
-func sweepVocabularyFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVocabularyFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceProfile        = newProfileResource
+	ResourceReviewTemplate = newReviewTemplateResource
+	ResourceWorkload       = newWorkloadResource
+
+	FindProfileByARN        = findProfileByARN
+	FindReviewTemplateByARN = findReviewTemplateByARN
+	FindWorkloadByID        = findWorkloadByID
+)
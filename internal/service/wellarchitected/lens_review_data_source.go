@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource("aws_wellarchitected_lens_review", name="Lens Review")
+func newLensReviewDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &lensReviewDataSource{}, nil
+}
+
+type lensReviewDataSource struct {
+	framework.DataSourceWithModel[lensReviewDataSourceModel]
+}
+
+func (d *lensReviewDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+			},
+			"lens_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"lens_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"lens_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LensStatus](),
+				Computed:   true,
+			},
+			"lens_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"milestone_number": schema.Int64Attribute{
+				Optional: true,
+			},
+			"notes": schema.StringAttribute{
+				Computed: true,
+			},
+			"pillar_review_summaries": framework.DataSourceComputedListOfObjectAttribute[pillarReviewSummaryModel](ctx),
+			"prioritized_risk_counts": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"profiles": framework.DataSourceComputedListOfObjectAttribute[workloadProfileModel](ctx),
+			"risk_counts": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *lensReviewDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data lensReviewDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WellArchitectedClient(ctx)
+
+	var input wellarchitected.GetLensReviewInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	workloadID, lensAlias := fwflex.StringValueFromFramework(ctx, data.WorkloadID), fwflex.StringValueFromFramework(ctx, data.LensAlias)
+	output, err := findLensReview(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Review (%s/%s)", workloadID, lensAlias), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("PrioritizedRiskCounts"), fwflex.WithIgnoredFieldNamesAppend("RiskCounts"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	var diags diag.Diagnostics
+	data.PrioritizedRiskCounts, diags = flattenRiskCounts(ctx, output.PrioritizedRiskCounts)
+	response.Diagnostics.Append(diags...)
+	data.RiskCounts, diags = flattenRiskCounts(ctx, output.RiskCounts)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenRiskCounts(ctx context.Context, apiObject map[string]int32) (fwtypes.MapValueOf[types.Int64], diag.Diagnostics) {
+	elements := make(map[string]attr.Value, len(apiObject))
+	for k, v := range apiObject {
+		elements[k] = types.Int64Value(int64(v))
+	}
+
+	return fwtypes.NewMapValueOf[types.Int64](ctx, elements)
+}
+
+func findLensReview(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.GetLensReviewInput) (*awstypes.LensReview, error) {
+	output, err := conn.GetLensReview(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LensReview == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LensReview, nil
+}
+
+type lensReviewDataSourceModel struct {
+	framework.WithRegionModel
+	LensAlias             types.String                                              `tfsdk:"lens_alias"`
+	LensARN               types.String                                              `tfsdk:"lens_arn"`
+	LensName              types.String                                              `tfsdk:"lens_name"`
+	LensStatus            fwtypes.StringEnum[awstypes.LensStatus]                   `tfsdk:"lens_status"`
+	LensVersion           types.String                                              `tfsdk:"lens_version"`
+	MilestoneNumber       types.Int64                                               `tfsdk:"milestone_number"`
+	Notes                 types.String                                              `tfsdk:"notes"`
+	PillarReviewSummaries fwtypes.ListNestedObjectValueOf[pillarReviewSummaryModel] `tfsdk:"pillar_review_summaries"`
+	PrioritizedRiskCounts fwtypes.MapValueOf[types.Int64]                           `tfsdk:"prioritized_risk_counts"`
+	Profiles              fwtypes.ListNestedObjectValueOf[workloadProfileModel]     `tfsdk:"profiles"`
+	RiskCounts            fwtypes.MapValueOf[types.Int64]                           `tfsdk:"risk_counts"`
+	UpdatedAt             timetypes.RFC3339                                         `tfsdk:"updated_at"`
+	WorkloadID            types.String                                              `tfsdk:"workload_id"`
+}
+
+type pillarReviewSummaryModel struct {
+	Notes      types.String `tfsdk:"notes"`
+	PillarID   types.String `tfsdk:"pillar_id"`
+	PillarName types.String `tfsdk:"pillar_name"`
+}
+
+type workloadProfileModel struct {
+	ProfileARN     types.String `tfsdk:"profile_arn"`
+	ProfileVersion types.String `tfsdk:"profile_version"`
+}
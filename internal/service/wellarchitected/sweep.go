@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_wellarchitected_workload", sweepWorkloads)
+	awsv2.Register("aws_wellarchitected_profile", sweepProfiles, "aws_wellarchitected_workload")
+	awsv2.Register("aws_wellarchitected_review_template", sweepReviewTemplates, "aws_wellarchitected_workload")
+}
+
+func sweepWorkloads(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WellArchitectedClient(ctx)
+	var input wellarchitected.ListWorkloadsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := wellarchitected.NewListWorkloadsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.WorkloadSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newWorkloadResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WorkloadId))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepProfiles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WellArchitectedClient(ctx)
+	input := wellarchitected.ListProfilesInput{
+		ProfileOwnerType: awstypes.ProfileOwnerTypeSelf,
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := wellarchitected.NewListProfilesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProfileSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newProfileResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.ProfileArn))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepReviewTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WellArchitectedClient(ctx)
+	var input wellarchitected.ListReviewTemplatesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := wellarchitected.NewListReviewTemplatesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ReviewTemplates {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newReviewTemplateResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.TemplateArn))),
+			)
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_profile" "test" {
+  name        = var.rName
+  description = "test"
+
+  profile_question {
+    question_id         = "workload_type"
+    selected_choice_ids = ["workload_type_new"]
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,25 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_workload" "test" {
+  region = var.region
+
+  workload_name   = var.rName
+  description     = "test"
+  environment     = "PREPRODUCTION"
+  lenses          = ["wellarchitected"]
+  review_owner    = "owner@example.com"
+  non_aws_regions = ["on-premises"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "null" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name   = var.rName
+  description     = "test"
+  environment     = "PREPRODUCTION"
+  lenses          = ["wellarchitected"]
+  review_owner    = "owner@example.com"
+  non_aws_regions = ["on-premises"]
+
+  tags = {
+    (var.unknownTagKey) = null_resource.test.id
+  }
+}
+
+resource "null_resource" "test" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "unknownTagKey" {
+  type     = string
+  nullable = false
+}
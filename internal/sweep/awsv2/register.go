@@ -4,45 +4,137 @@
 package awsv2
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
-	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+)
+
+type registration struct {
+	name string
+	f    sweep.SweeperFn
+	// legacyF is set instead of f for sweepers added with AddTestSweepers.
+	legacyF      SweeperFunc
+	dependencies []string
+	// servicePackage is the name of the service package that implements the sweeper.
+	servicePackage string
+}
+
+var (
+	registrations   = make(map[string]registration)
+	registrationsMu sync.Mutex
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	registrationsMu.Lock()
+	registrations[name] = registration{
+		name:           name,
+		f:              f,
+		dependencies:   dependencies,
+		servicePackage: servicePackageName(f),
+	}
+	registrationsMu.Unlock()
+
+	addTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
-			ctx := sweep.Context(region)
-			ctx = log.WithResourceType(ctx, name)
-
-			client, err := sweep.SharedRegionalSweepClient(ctx, region)
-			if err != nil {
-				return fmt.Errorf("getting client: %w", err)
-			}
-			tflog.Info(ctx, "listing resources")
-			sweepResources, err := f(ctx, client)
-
-			if SkipSweepError(err) {
-				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
-					"error": err.Error(),
-				})
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("listing %q (%s): %w", name, region, err)
-			}
-
-			err = sweep.SweepOrchestrator(ctx, sweepResources)
-			if err != nil {
-				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
-			}
-
-			return nil
+			_, _, err := sweepRegion(sweep.Context(region), region, name, f)
+
+			return err
 		},
 		Dependencies: dependencies,
 	})
 }
+
+// SweeperFunc is a sweeper that lists and deletes resources in a single Region.
+// The context is cancelled when the sweep is interrupted and carries the sweeper options and any rate limiter,
+// so SweepOrchestrator and SkipResourceAction calls made with it are rate limited and counted by Run.
+type SweeperFunc func(ctx context.Context, region string) error
+
+// Sweeper is a sweeper added with AddTestSweepers.
+// It is equivalent to resource.Sweeper, except that F receives a context.
+type Sweeper struct {
+	Name         string
+	F            SweeperFunc
+	Dependencies []string
+}
+
+// AddTestSweepers adds a sweeper implemented as a SweeperFunc.
+// It is equivalent to resource.AddTestSweepers, except that the sweeper is also run by Run.
+func AddTestSweepers(name string, s *Sweeper) {
+	registrationsMu.Lock()
+	registrations[name] = registration{
+		name:           name,
+		legacyF:        s.F,
+		dependencies:   s.Dependencies,
+		servicePackage: servicePackageName(s.F),
+	}
+	registrationsMu.Unlock()
+
+	addTestSweepers(name, &resource.Sweeper{
+		Name: s.Name,
+		F: func(region string) error {
+			return s.F(sweep.WithResourceType(sweep.Context(region), name), region)
+		},
+		Dependencies: s.Dependencies,
+	})
+}
+
+// sweepRegion runs a single sweeper in a single Region.
+// skipped is true if the sweeper is not supported in the Region.
+func sweepRegion(ctx context.Context, region, name string, f sweep.SweeperFn) (stats sweep.Stats, skipped bool, err error) {
+	ctx = sweep.WithResourceType(ctx, name)
+
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return stats, false, fmt.Errorf("getting client: %w", err)
+	}
+	tflog.Info(ctx, "listing resources")
+	sweepResources, err := f(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return stats, true, nil
+	}
+	if err != nil {
+		return stats, false, fmt.Errorf("listing %q (%s): %w", name, region, err)
+	}
+
+	stats, err = sweep.Orchestrate(ctx, sweepResources)
+	if err != nil {
+		return stats, false, fmt.Errorf("sweeping %q (%s): %w", name, region, err)
+	}
+
+	return stats, false, nil
+}
+
+// newClient is overridden in unit tests.
+var newClient = sweep.SharedRegionalSweepClient
+
+// addTestSweepers is overridden in unit tests.
+var addTestSweepers = resource.AddTestSweepers
+
+// servicePackageName returns the name of the package implementing the specified function,
+// e.g. "ec2" for "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.sweepVPCs".
+func servicePackageName(f any) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	name, _, _ = strings.Cut(name, ".")
+
+	return name
+}
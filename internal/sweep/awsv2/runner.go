@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsv2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+// RunOptions configures Run.
+type RunOptions struct {
+	// Regions to sweep.
+	Regions []string
+	// Sweepers filters the sweepers to run, along with all of their dependencies. If empty, all registered sweepers are run.
+	Sweepers []string
+	// Parallelism is the maximum number of sweepers that run concurrently. Defaults to 1.
+	Parallelism int
+	// RateLimit is the maximum number of deletions started per second for each service package in each Region.
+	// Zero means unlimited.
+	RateLimit float64
+	// AllowFailures runs sweepers even if one of their dependencies failed.
+	AllowFailures bool
+}
+
+const (
+	ResultStatusFailed    = "failed"
+	ResultStatusSkipped   = "skipped"
+	ResultStatusSucceeded = "succeeded"
+)
+
+// Result is the outcome of running a single sweeper in a single Region.
+type Result struct {
+	ResourceType string `json:"resource_type"`
+	Region       string `json:"region"`
+	Status       string `json:"status"`
+	Deleted      int    `json:"deleted"`
+	Skipped      int    `json:"skipped"`
+	Failed       int    `json:"failed"`
+	Error        string `json:"error,omitempty"`
+
+	// blocked is true if the sweeper did not run because a dependency failed.
+	blocked bool
+}
+
+// Summary is the consolidated outcome of Run.
+type Summary struct {
+	DryRun  bool      `json:"dry_run"`
+	Deleted int       `json:"deleted"`
+	Skipped int       `json:"skipped"`
+	Failed  int       `json:"failed"`
+	Results []*Result `json:"results"`
+}
+
+// Run runs registered sweepers in each Region.
+// Sweepers run concurrently once all of their dependencies (in the same Region) have completed.
+// Sweepers whose dependencies are not registered are skipped, as are their dependents.
+// The returned error is non-nil if the sweepers could not be scheduled or if any sweeper failed.
+func Run(ctx context.Context, options RunOptions) (*Summary, error) {
+	registrationsMu.Lock()
+	registered := make(map[string]registration, len(registrations))
+	for k, v := range registrations {
+		registered[k] = v
+	}
+	registrationsMu.Unlock()
+
+	graph, err := newGraph(registered)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(options.Sweepers) > 0 {
+		graph, err = subgraph(graph, options.Sweepers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// OverallOrder detects dependency cycles.
+	order, err := graph.OverallOrder()
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	sweepOptions, err := sweep.OptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := max(options.Parallelism, 1)
+	workers := make(chan struct{}, parallelism)
+
+	type key struct {
+		region, name string
+	}
+	done := make(map[key]chan struct{})
+	results := make(map[key]*Result)
+	for _, region := range options.Regions {
+		for _, name := range order {
+			k := key{region, name}
+			done[k] = make(chan struct{})
+			results[k] = &Result{ResourceType: name, Region: region}
+		}
+	}
+
+	var limitersMu sync.Mutex
+	limiters := make(map[key]*sweep.RateLimiter)
+	limiter := func(region, servicePackage string) *sweep.RateLimiter {
+		limitersMu.Lock()
+		defer limitersMu.Unlock()
+
+		k := key{region, servicePackage}
+		if _, ok := limiters[k]; !ok {
+			limiters[k] = sweep.NewRateLimiter(options.RateLimit)
+		}
+
+		return limiters[k]
+	}
+
+	var wg sync.WaitGroup
+	for _, region := range options.Regions {
+		for _, name := range order {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				k := key{region, name}
+				result := results[k]
+				defer close(done[k])
+
+				dependencies, _ := graph.DirectDependenciesOf(name)
+				for _, dependency := range dependencies {
+					<-done[key{region, dependency}]
+
+					if dependencyResult := results[key{region, dependency}]; !options.AllowFailures && (dependencyResult.Status == ResultStatusFailed || dependencyResult.blocked) {
+						result.Status = ResultStatusSkipped
+						result.Error = fmt.Sprintf("dependency %q did not complete", dependency)
+						result.blocked = true
+						return
+					}
+				}
+
+				r, ok := registered[name]
+				if !ok {
+					result.Status = ResultStatusSkipped
+					result.Error = "not registered"
+					result.blocked = true
+					return
+				}
+
+				select {
+				case workers <- struct{}{}:
+					defer func() { <-workers }()
+				case <-ctx.Done():
+					result.Status = ResultStatusSkipped
+					result.Error = ctx.Err().Error()
+					result.blocked = true
+					return
+				}
+
+				sweepCtx := sweep.RegionalContext(ctx, region)
+				sweepCtx = sweep.WithOptions(sweepCtx, sweepOptions)
+				if l := limiter(region, r.servicePackage); l != nil {
+					sweepCtx = sweep.WithRateLimiter(sweepCtx, l)
+				}
+
+				var (
+					stats   sweep.Stats
+					skipped bool
+					err     error
+				)
+				if r.f != nil {
+					stats, skipped, err = sweepRegion(sweepCtx, region, name, r.f)
+				} else {
+					// Sweepers added with AddTestSweepers report their counts through the context.
+					var collector sweep.StatsCollector
+					err = r.legacyF(sweep.WithStatsCollector(sweep.WithResourceType(sweepCtx, name), &collector), region)
+					stats = collector.Stats()
+				}
+
+				result.Deleted, result.Skipped, result.Failed = stats.Deleted, stats.Kept, stats.Failed
+				switch {
+				case err != nil:
+					result.Status = ResultStatusFailed
+					result.Error = err.Error()
+					tflog.Error(sweepCtx, "Sweeper failed", map[string]any{
+						"error": err.Error(),
+					})
+				case skipped:
+					result.Status = ResultStatusSkipped
+				default:
+					result.Status = ResultStatusSucceeded
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	summary := &Summary{
+		DryRun: sweepOptions.DryRun,
+	}
+	var failed int
+	for _, region := range options.Regions {
+		for _, name := range order {
+			result := results[key{region, name}]
+
+			summary.Results = append(summary.Results, result)
+			summary.Deleted += result.Deleted
+			summary.Skipped += result.Skipped
+			summary.Failed += result.Failed
+
+			if result.Status == ResultStatusFailed {
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return summary, fmt.Errorf("%d sweeper(s) failed", failed)
+	}
+
+	return summary, nil
+}
+
+// newGraph returns the dependency graph of all registered sweepers.
+// Dependencies that are not themselves registered are added as nodes so that their dependents are blocked.
+func newGraph(registered map[string]registration) (*depgraph.Graph, error) {
+	graph := depgraph.New()
+
+	names := make([]string, 0, len(registered))
+	for name := range registered {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		graph.AddNode(name)
+		for _, dependency := range registered[name].dependencies {
+			graph.AddNode(dependency)
+		}
+	}
+
+	for _, name := range names {
+		for _, dependency := range registered[name].dependencies {
+			if err := graph.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return graph, nil
+}
+
+// subgraph returns the graph containing only the sweepers matching the specified filters and their transitive dependencies.
+// As with the plugin-testing -sweep-run flag, a sweeper matches if its name contains a filter (ignoring case).
+func subgraph(graph *depgraph.Graph, filters []string) (*depgraph.Graph, error) {
+	order, err := graph.OverallOrder()
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	keep := make(map[string]bool)
+
+	for _, name := range order {
+		if !slices.ContainsFunc(filters, func(filter string) bool {
+			return strings.Contains(strings.ToLower(name), strings.ToLower(filter))
+		}) {
+			continue
+		}
+
+		dependencies, err := graph.DependenciesOf(name)
+		if err != nil {
+			return nil, fmt.Errorf("ordering sweepers: %w", err)
+		}
+
+		keep[name] = true
+		for _, dependency := range dependencies {
+			keep[dependency] = true
+		}
+	}
+
+	if len(keep) == 0 {
+		return nil, fmt.Errorf("no sweepers match %q", strings.Join(filters, ","))
+	}
+
+	for _, name := range order {
+		if !keep[name] {
+			graph.RemoveNode(name)
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsv2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	err error
+}
+
+func (s testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return s.err
+}
+
+// testRegistrations replaces the registered sweepers for the duration of a test.
+func testRegistrations(t *testing.T, rs ...registration) {
+	t.Helper()
+
+	saved, savedNewClient, savedAddTestSweepers := registrations, newClient, addTestSweepers
+	registrations = make(map[string]registration)
+	for _, r := range rs {
+		registrations[r.name] = r
+	}
+	newClient = func(context.Context, string) (*conns.AWSClient, error) {
+		return new(conns.AWSClient), nil
+	}
+	addTestSweepers = func(string, *resource.Sweeper) {}
+
+	t.Cleanup(func() {
+		registrations, newClient, addTestSweepers = saved, savedNewClient, savedAddTestSweepers
+	})
+}
+
+// recorder records the order in which sweepers run.
+type recorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *recorder) sweeper(name string, sweepables ...sweep.Sweepable) sweep.SweeperFn {
+	return func(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+		r.mu.Lock()
+		r.order = append(r.order, name)
+		r.mu.Unlock()
+
+		return sweepables, nil
+	}
+}
+
+func (r *recorder) ran(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Contains(r.order, name)
+}
+
+func (r *recorder) before(a, b string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Index(r.order, a) < slices.Index(r.order, b)
+}
+
+func TestRun_dependencyOrder(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	var rec recorder
+	testRegistrations(t,
+		registration{name: "aws_a", f: rec.sweeper("aws_a", testSweepable{}, testSweepable{})},
+		registration{name: "aws_b", f: rec.sweeper("aws_b", testSweepable{}), dependencies: []string{"aws_a"}},
+		registration{name: "aws_c", f: rec.sweeper("aws_c"), dependencies: []string{"aws_a", "aws_b"}},
+		registration{name: "aws_d", f: rec.sweeper("aws_d", testSweepable{})},
+	)
+
+	summary, err := Run(context.Background(), RunOptions{
+		Regions:     []string{"us-west-2"},
+		Parallelism: 4,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !rec.before("aws_a", "aws_b") || !rec.before("aws_b", "aws_c") {
+		t.Errorf("unexpected order: %v", rec.order)
+	}
+
+	if got, want := len(summary.Results), 4; got != want {
+		t.Errorf("%d results, want %d", got, want)
+	}
+	if got, want := summary.Deleted, 4; got != want {
+		t.Errorf("deleted %d, want %d", got, want)
+	}
+	for _, result := range summary.Results {
+		if result.Status != ResultStatusSucceeded {
+			t.Errorf("%s (%s): status %s", result.ResourceType, result.Region, result.Status)
+		}
+	}
+}
+
+func TestRun_failedDependency(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	testCases := map[string]struct {
+		allowFailures bool
+		wantRan       bool
+		wantStatus    string
+	}{
+		"blocked": {
+			wantStatus: ResultStatusSkipped,
+		},
+		"allow failures": {
+			allowFailures: true,
+			wantRan:       true,
+			wantStatus:    ResultStatusSucceeded,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Overrides registrations.
+		t.Run(name, func(t *testing.T) {
+			var rec recorder
+			testRegistrations(t,
+				registration{name: "aws_a", f: rec.sweeper("aws_a", testSweepable{err: errors.New("boom")})},
+				registration{name: "aws_b", f: rec.sweeper("aws_b"), dependencies: []string{"aws_a"}},
+				registration{name: "aws_c", f: rec.sweeper("aws_c"), dependencies: []string{"aws_b"}},
+			)
+
+			summary, err := Run(context.Background(), RunOptions{
+				Regions:       []string{"us-west-2", "us-east-1"},
+				Parallelism:   2,
+				AllowFailures: testCase.allowFailures,
+			})
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := rec.ran("aws_c"), testCase.wantRan; got != want {
+				t.Errorf("aws_c ran: %t, want %t", got, want)
+			}
+
+			for _, result := range summary.Results {
+				switch result.ResourceType {
+				case "aws_a":
+					if result.Status != ResultStatusFailed || result.Failed != 1 {
+						t.Errorf("%s (%s): status %s, failed %d", result.ResourceType, result.Region, result.Status, result.Failed)
+					}
+				case "aws_c":
+					if got, want := result.Status, testCase.wantStatus; got != want {
+						t.Errorf("%s (%s): status %s, want %s", result.ResourceType, result.Region, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRun_sweepers(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	var rec recorder
+	testRegistrations(t,
+		registration{name: "aws_a", f: rec.sweeper("aws_a")},
+		registration{name: "aws_b", f: rec.sweeper("aws_b"), dependencies: []string{"aws_a", "aws_unregistered"}},
+		registration{name: "aws_c", f: rec.sweeper("aws_c")},
+	)
+
+	summary, err := Run(context.Background(), RunOptions{
+		Regions:  []string{"us-west-2"},
+		Sweepers: []string{"aws_b"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// aws_b depends on an unregistered sweeper and so is blocked.
+	if !rec.ran("aws_a") || rec.ran("aws_b") || rec.ran("aws_c") {
+		t.Errorf("unexpected sweepers run: %v", rec.order)
+	}
+
+	for _, result := range summary.Results {
+		switch result.ResourceType {
+		case "aws_unregistered", "aws_b":
+			if result.Status != ResultStatusSkipped {
+				t.Errorf("%s: status %s, want %s", result.ResourceType, result.Status, ResultStatusSkipped)
+			}
+		}
+	}
+
+	if _, err := Run(context.Background(), RunOptions{Sweepers: []string{"aws_unknown"}}); err == nil {
+		t.Error("expected error for unknown sweeper")
+	}
+}
+
+func TestRun_legacySweepers(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	var (
+		rec      recorder
+		mu       sync.Mutex
+		regions  []string
+		legacyFn = func(ctx context.Context, region string) error {
+			mu.Lock()
+			regions = append(regions, region)
+			mu.Unlock()
+
+			rec.mu.Lock()
+			rec.order = append(rec.order, "aws_legacy")
+			rec.mu.Unlock()
+
+			return nil
+		}
+	)
+	testRegistrations(t,
+		registration{name: "aws_legacy", legacyF: legacyFn},
+		registration{name: "aws_a", f: rec.sweeper("aws_a"), dependencies: []string{"aws_legacy"}},
+	)
+
+	summary, err := Run(context.Background(), RunOptions{
+		Regions:     []string{"us-west-2", "us-east-1"},
+		Parallelism: 2,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(regions), 2; got != want {
+		t.Errorf("legacy sweeper ran in %d Regions, want %d", got, want)
+	}
+
+	if !rec.before("aws_legacy", "aws_a") {
+		t.Errorf("unexpected order: %v", rec.order)
+	}
+
+	for _, result := range summary.Results {
+		if result.Status != ResultStatusSucceeded {
+			t.Errorf("%s (%s): status %s", result.ResourceType, result.Region, result.Status)
+		}
+	}
+}
+
+func TestRun_legacySweeperRateLimit(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	testRegistrations(t)
+
+	AddTestSweepers("aws_legacy_rate_limited", &Sweeper{
+		Name: "aws_legacy_rate_limited",
+		F: func(ctx context.Context, region string) error {
+			return sweep.SweepOrchestrator(ctx, []sweep.Sweepable{testSweepable{}, testSweepable{}, testSweepable{}})
+		},
+	})
+
+	start := time.Now()
+	summary, err := Run(context.Background(), RunOptions{
+		Regions:   []string{"us-west-2"},
+		RateLimit: 10,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The first deletion starts immediately and each subsequent one waits 100ms.
+	if got, want := time.Since(start), 200*time.Millisecond; got < want {
+		t.Errorf("took %s, want at least %s", got, want)
+	}
+
+	if got, want := summary.Deleted, 3; got != want {
+		t.Errorf("deleted %d, want %d", got, want)
+	}
+}
+
+func TestRun_legacySweeperCancellation(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	testRegistrations(t)
+
+	started := make(chan struct{})
+	AddTestSweepers("aws_legacy_cancelled", &Sweeper{
+		Name: "aws_legacy_cancelled",
+		F: func(ctx context.Context, region string) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	summary, err := Run(ctx, RunOptions{Regions: []string{"us-west-2"}})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	result := summary.Results[0]
+	if result.Status != ResultStatusFailed || result.Error != context.Canceled.Error() {
+		t.Errorf("%s (%s): status %s, error %q", result.ResourceType, result.Region, result.Status, result.Error)
+	}
+}
+
+func TestRun_context(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	type contextKey struct{}
+
+	var got any
+	testRegistrations(t,
+		registration{name: "aws_a", f: func(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+			got = ctx.Value(contextKey{})
+			return nil, nil
+		}},
+	)
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "parent")
+	if _, err := Run(ctx, RunOptions{Regions: []string{"us-west-2"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != "parent" {
+		t.Errorf("sweeper context not derived from Run context")
+	}
+}
+
+func TestRun_cycle(t *testing.T) { //nolint:paralleltest // Overrides registrations.
+	var rec recorder
+	testRegistrations(t,
+		registration{name: "aws_a", f: rec.sweeper("aws_a"), dependencies: []string{"aws_b"}},
+		registration{name: "aws_b", f: rec.sweeper("aws_b"), dependencies: []string{"aws_a"}},
+	)
+
+	if _, err := Run(context.Background(), RunOptions{Regions: []string{"us-west-2"}}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(rec.order) > 0 {
+		t.Errorf("unexpected sweepers run: %v", rec.order)
+	}
+}
+
+func TestServicePackageName(t *testing.T) {
+	t.Parallel()
+
+	if got, want := servicePackageName(sweep.SharedRegionalSweepClient), "sweep"; got != want {
+		t.Errorf("servicePackageName = %q, want %q", got, want)
+	}
+}
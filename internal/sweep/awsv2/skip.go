@@ -114,6 +114,10 @@ func SkipSweepError(err error) bool {
 	if tfawserr.ErrMessageContains(err, "UnauthorizedException", "API is not available in") {
 		return true
 	}
+	// Example (mgn): UninitializedAccountException: Account not initialized
+	if tfawserr.ErrCodeEquals(err, "UninitializedAccountException") {
+		return true
+	}
 	// Example (GovCloud): UnknownOperationException: Operation is disabled in this region
 	if tfawserr.ErrMessageContains(err, "UnknownOperationException", "Operation is disabled in this region") {
 		return true
@@ -10,12 +10,44 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	resourceTypeKey
+)
+
 func Context(region string) context.Context {
-	ctx := context.Background()
+	return RegionalContext(context.Background(), region)
+}
 
+// RegionalContext returns a context derived from ctx for sweeping the specified Region.
+// The returned context is cancelled when ctx is cancelled.
+func RegionalContext(ctx context.Context, region string) context.Context {
 	ctx = tfsdklog.RegisterStdlogSink(ctx)
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionKey, region)
+
 	return ctx
 }
+
+// WithResourceType returns a context that identifies the resource type being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey).(string)
+
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeKey).(string)
+
+	return v
+}
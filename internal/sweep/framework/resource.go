@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -104,6 +107,35 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// Metadata describes the resource using its sweeper attributes.
+// If no "id" attribute is set, the ID is formed from all attribute values.
+func (sr *sweepResource) Metadata(ctx context.Context) metadata.Metadata {
+	var m metadata.Metadata
+	var values []string
+
+	for _, attr := range sr.attributes {
+		switch v := attr.value; attr.path {
+		case names.AttrID:
+			m.ID = fmt.Sprint(v)
+		case names.AttrName:
+			m.Name = fmt.Sprint(v)
+		case names.AttrTags:
+			if tags, ok := v.(map[string]string); ok {
+				m.Tags = tags
+			}
+			continue
+		}
+
+		values = append(values, fmt.Sprint(attr.value))
+	}
+
+	if m.ID == "" {
+		m.ID = strings.Join(values, ",")
+	}
+
+	return m
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Metadata describes the resource deleted by a Sweepable.
+// Any field may be zero-valued if the sweeper does not know it.
+type Metadata struct {
+	ID   string
+	Name string
+	// Tags is nil if the resource's tags are unknown and empty if the resource has no tags.
+	Tags      map[string]string
+	CreatedAt time.Time
+}
+
+// Describer is implemented by Sweepables that can describe the resource they delete.
+type Describer interface {
+	Metadata(ctx context.Context) Metadata
+}
+
+func (m Metadata) String() string {
+	id := m.ID
+	if id == "" {
+		id = "<unknown>"
+	}
+
+	if m.Name != "" && m.Name != m.ID {
+		return fmt.Sprintf("%s (%s)", id, m.Name)
+	}
+
+	return id
+}
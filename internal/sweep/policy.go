@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/metadata"
+)
+
+type (
+	Metadata  = metadata.Metadata
+	Describer = metadata.Describer
+)
+
+type describedSweepable struct {
+	Sweepable
+	metadata Metadata
+}
+
+// NewDescribedSweepable returns a Sweepable that reports the specified Metadata.
+// Non-zero fields override any Metadata reported by the wrapped Sweepable.
+// A non-nil, empty Tags map records that the resource is known to have no tags.
+func NewDescribedSweepable(sweepable Sweepable, m Metadata) Sweepable {
+	return &describedSweepable{
+		Sweepable: sweepable,
+		metadata:  m,
+	}
+}
+
+func (ds *describedSweepable) Metadata(ctx context.Context) Metadata {
+	m := Describe(ctx, ds.Sweepable)
+
+	if v := ds.metadata.ID; v != "" {
+		m.ID = v
+	}
+	if v := ds.metadata.Name; v != "" {
+		m.Name = v
+	}
+	if v := ds.metadata.Tags; v != nil {
+		m.Tags = maps.Clone(v)
+	}
+	if v := ds.metadata.CreatedAt; !v.IsZero() {
+		m.CreatedAt = v
+	}
+
+	return m
+}
+
+// Describe returns the Metadata reported by a Sweepable, or empty Metadata if it reports none.
+func Describe(ctx context.Context, sweepable Sweepable) Metadata {
+	if v, ok := sweepable.(Describer); ok {
+		return v.Metadata(ctx)
+	}
+
+	return Metadata{}
+}
+
+// KeepPolicy decides whether a resource returned by a sweeper must be kept.
+type KeepPolicy interface {
+	// Keep returns true and a human-readable reason if the resource must not be deleted.
+	Keep(m Metadata) (bool, string)
+}
+
+type KeepPolicyFunc func(m Metadata) (bool, string)
+
+func (f KeepPolicyFunc) Keep(m Metadata) (bool, string) {
+	return f(m)
+}
+
+// KeepTagged keeps resources tagged with the specified key.
+// If value is non-empty the tag must also have that value.
+// Resources with unknown tags are kept.
+func KeepTagged(key, value string) KeepPolicy {
+	return KeepPolicyFunc(func(m Metadata) (bool, string) {
+		if m.Tags == nil {
+			return true, "tags unknown"
+		}
+
+		v, ok := m.Tags[key]
+
+		if !ok {
+			return false, ""
+		}
+
+		if value == "" {
+			return true, fmt.Sprintf("tagged with %q", key)
+		}
+
+		if v == value {
+			return true, fmt.Sprintf("tagged with %q = %q", key, value)
+		}
+
+		return false, ""
+	})
+}
+
+// now is overridden in unit tests.
+var now = time.Now
+
+// KeepNewerThan keeps resources created less than the specified duration ago.
+// Resources with an unknown creation time are kept.
+func KeepNewerThan(d time.Duration) KeepPolicy {
+	return KeepPolicyFunc(func(m Metadata) (bool, string) {
+		if m.CreatedAt.IsZero() {
+			return true, "creation time unknown"
+		}
+
+		if age := now().Sub(m.CreatedAt); age < d {
+			return true, fmt.Sprintf("created %s ago, newer than %s", age.Round(time.Second), d)
+		}
+
+		return false, ""
+	})
+}
+
+// KeepUnlessNamePrefixed keeps resources whose name (or ID, if the name is unknown)
+// does not start with one of the specified prefixes.
+func KeepUnlessNamePrefixed(prefixes ...string) KeepPolicy {
+	return KeepPolicyFunc(func(m Metadata) (bool, string) {
+		name := m.Name
+		if name == "" {
+			name = m.ID
+		}
+
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				return false, ""
+			}
+		}
+
+		if name == "" {
+			return true, "name unknown, not in prefix allow-list"
+		}
+
+		return true, fmt.Sprintf("name %q not in prefix allow-list", name)
+	})
+}
+
+// KeepAny keeps resources kept by any of the specified policies.
+func KeepAny(policies ...KeepPolicy) KeepPolicy {
+	return KeepPolicyFunc(func(m Metadata) (bool, string) {
+		for _, policy := range policies {
+			if keep, reason := policy.Keep(m); keep {
+				return true, reason
+			}
+		}
+
+		return false, ""
+	})
+}
+
+// Options configures how SweepOrchestrator handles the resources returned by a sweeper.
+type Options struct {
+	// DryRun reports what would be deleted without deleting anything.
+	DryRun bool
+	// KeepPolicy, if set, is evaluated against each resource before it is deleted.
+	KeepPolicy KeepPolicy
+}
+
+// OptionsFromEnv returns Options configured from environment variables.
+func OptionsFromEnv() (Options, error) {
+	var options Options
+	var policies []KeepPolicy
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return options, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		options.DryRun = dryRun
+	}
+
+	if v := os.Getenv(envvar.SweepKeepTags); v != "" {
+		for tag := range strings.SplitSeq(v, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(tag), "=")
+			if key == "" {
+				return options, fmt.Errorf("environment variable %s: empty tag key in %q", envvar.SweepKeepTags, v)
+			}
+			policies = append(policies, KeepTagged(key, value))
+		}
+	}
+
+	if v := os.Getenv(envvar.SweepKeepNewerThan); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return options, fmt.Errorf("environment variable %s: %w", envvar.SweepKeepNewerThan, err)
+		}
+		policies = append(policies, KeepNewerThan(d))
+	}
+
+	if v := os.Getenv(envvar.SweepNamePrefixes); v != "" {
+		var prefixes []string
+		for prefix := range strings.SplitSeq(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		policies = append(policies, KeepUnlessNamePrefixed(prefixes...))
+	}
+
+	if len(policies) > 0 {
+		options.KeepPolicy = KeepAny(policies...)
+	}
+
+	return options, nil
+}
+
+type optionsKey struct{}
+
+// WithOptions returns a context carrying the specified Options, overriding those configured from the environment.
+func WithOptions(ctx context.Context, options Options) context.Context {
+	return context.WithValue(ctx, optionsKey{}, options)
+}
+
+func optionsFromContext(ctx context.Context) (Options, error) {
+	if v, ok := ctx.Value(optionsKey{}).(Options); ok {
+		return v, nil
+	}
+
+	return OptionsFromEnv()
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestKeepPolicies(t *testing.T) { //nolint:paralleltest // Overrides now.
+	createdAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return createdAt.Add(2 * time.Hour) }
+	t.Cleanup(func() { now = time.Now })
+
+	testCases := map[string]struct {
+		policy   KeepPolicy
+		metadata Metadata
+		wantKeep bool
+	}{
+		"tag key present": {
+			policy:   KeepTagged("keep", ""),
+			metadata: Metadata{Tags: map[string]string{"keep": "anything"}},
+			wantKeep: true,
+		},
+		"tag key absent": {
+			policy:   KeepTagged("keep", ""),
+			metadata: Metadata{Tags: map[string]string{"other": "x"}},
+		},
+		"tag value matches": {
+			policy:   KeepTagged("team", "platform"),
+			metadata: Metadata{Tags: map[string]string{"team": "platform"}},
+			wantKeep: true,
+		},
+		"tag value differs": {
+			policy:   KeepTagged("team", "platform"),
+			metadata: Metadata{Tags: map[string]string{"team": "data"}},
+		},
+		"newer than": {
+			policy:   KeepNewerThan(3 * time.Hour),
+			metadata: Metadata{CreatedAt: createdAt},
+			wantKeep: true,
+		},
+		"older than": {
+			policy:   KeepNewerThan(time.Hour),
+			metadata: Metadata{CreatedAt: createdAt},
+		},
+		"creation time unknown": {
+			policy:   KeepNewerThan(time.Hour),
+			wantKeep: true,
+		},
+		"tags unknown": {
+			policy:   KeepTagged("keep", ""),
+			wantKeep: true,
+		},
+		"no tags": {
+			policy:   KeepTagged("keep", ""),
+			metadata: Metadata{Tags: map[string]string{}},
+		},
+		"name prefixed": {
+			policy:   KeepUnlessNamePrefixed("tf-acc-test", "tf-test"),
+			metadata: Metadata{ID: "i-1", Name: "tf-test-abc"},
+		},
+		"name not prefixed": {
+			policy:   KeepUnlessNamePrefixed("tf-acc-test"),
+			metadata: Metadata{ID: "i-1", Name: "production"},
+			wantKeep: true,
+		},
+		"name unknown ID prefixed": {
+			policy:   KeepUnlessNamePrefixed("tf-acc-test"),
+			metadata: Metadata{ID: "tf-acc-test-123"},
+		},
+		"name and ID unknown": {
+			policy:   KeepUnlessNamePrefixed("tf-acc-test"),
+			wantKeep: true,
+		},
+		"any kept": {
+			policy:   KeepAny(KeepTagged("keep", ""), KeepNewerThan(3*time.Hour)),
+			metadata: Metadata{CreatedAt: createdAt},
+			wantKeep: true,
+		},
+		"any none kept": {
+			policy:   KeepAny(KeepTagged("keep", ""), KeepNewerThan(time.Hour)),
+			metadata: Metadata{Tags: map[string]string{}, CreatedAt: createdAt},
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Overrides now.
+		t.Run(name, func(t *testing.T) {
+			keep, reason := testCase.policy.Keep(testCase.metadata)
+
+			if got, want := keep, testCase.wantKeep; got != want {
+				t.Errorf("Keep = %t, want %t", got, want)
+			}
+			if keep && reason == "" {
+				t.Error("expected a reason")
+			}
+		})
+	}
+}
+
+func TestOptionsFromEnv(t *testing.T) { //nolint:paralleltest // Uses t.Setenv.
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepKeepTags, "keep, team=platform")
+	t.Setenv(envvar.SweepKeepNewerThan, "24h")
+	t.Setenv(envvar.SweepNamePrefixes, "tf-acc-test")
+
+	options, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !options.DryRun {
+		t.Error("expected DryRun")
+	}
+
+	for _, m := range []Metadata{
+		{Name: "tf-acc-test-1", Tags: map[string]string{"keep": ""}},
+		{Name: "tf-acc-test-1", Tags: map[string]string{"team": "platform"}},
+		{Name: "tf-acc-test-1", CreatedAt: time.Now()},
+		{Name: "production"},
+	} {
+		if keep, _ := options.KeepPolicy.Keep(m); !keep {
+			t.Errorf("expected %v to be kept", m)
+		}
+	}
+
+	if keep, reason := options.KeepPolicy.Keep(Metadata{Name: "tf-acc-test-1", Tags: map[string]string{"team": "data"}, CreatedAt: time.Now().Add(-48 * time.Hour)}); keep {
+		t.Errorf("expected resource to be swept, kept: %s", reason)
+	}
+}
+
+func TestOptionsFromEnv_invalid(t *testing.T) { //nolint:paralleltest // Uses t.Setenv.
+	testCases := map[string]struct {
+		name, value string
+	}{
+		"dry run":         {envvar.SweepDryRun, "maybe"},
+		"keep tags":       {envvar.SweepKeepTags, "=value"},
+		"keep newer than": {envvar.SweepKeepNewerThan, "one day"},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Uses t.Setenv.
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(testCase.name, testCase.value)
+
+			if _, err := OptionsFromEnv(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+type testSweepable struct {
+	metadata Metadata
+	deleted  *atomic.Int32
+}
+
+func (s testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	s.deleted.Add(1)
+	return nil
+}
+
+func (s testSweepable) Metadata(context.Context) Metadata {
+	return s.metadata
+}
+
+func TestSweepOrchestrator_keepPolicy(t *testing.T) {
+	t.Parallel()
+
+	var deleted atomic.Int32
+	sweepables := []Sweepable{
+		testSweepable{metadata: Metadata{ID: "1", Name: "tf-acc-test-1", Tags: map[string]string{}}, deleted: &deleted},
+		testSweepable{metadata: Metadata{ID: "2", Name: "production", Tags: map[string]string{}}, deleted: &deleted},
+		testSweepable{metadata: Metadata{ID: "4", Name: "tf-acc-test-4"}, deleted: &deleted},
+		NewDescribedSweepable(testSweepable{deleted: &deleted}, Metadata{ID: "3", Name: "tf-acc-test-3", Tags: map[string]string{"keep": "true"}}),
+	}
+	ctx := WithOptions(Context("us-west-2"), Options{
+		KeepPolicy: KeepAny(KeepTagged("keep", ""), KeepUnlessNamePrefixed("tf-acc-test")),
+	})
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := deleted.Load(), int32(1); got != want {
+		t.Errorf("deleted %d, want %d", got, want)
+	}
+}
+
+func TestSweepOrchestrator_dryRun(t *testing.T) { //nolint:paralleltest // Overrides reportWriter.
+	var sb strings.Builder
+	reportWriter = &sb
+	t.Cleanup(func() { reportWriter = os.Stdout })
+
+	var deleted atomic.Int32
+	sweepables := []Sweepable{
+		testSweepable{metadata: Metadata{ID: "i-1", Name: "tf-acc-test-1"}, deleted: &deleted},
+		testSweepable{metadata: Metadata{ID: "i-2", Name: "production"}, deleted: &deleted},
+	}
+	ctx := WithResourceType(Context("us-west-2"), "aws_instance")
+	ctx = WithOptions(ctx, Options{
+		DryRun:     true,
+		KeepPolicy: KeepUnlessNamePrefixed("tf-acc-test"),
+	})
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("deleted %d in dry-run mode", got)
+	}
+
+	want := `[DRY RUN] us-west-2 aws_instance: would delete 1, would keep 1
+  delete i-1 (tf-acc-test-1)
+  keep   i-2 (production): name "production" not in prefix allow-list
+`
+	if got := sb.String(); got != want {
+		t.Errorf("report = %q, want %q", got, want)
+	}
+}
+
+func TestSkipResourceAction(t *testing.T) { //nolint:paralleltest // Overrides reportWriter.
+	var sb strings.Builder
+	reportWriter = &sb
+	t.Cleanup(func() { reportWriter = os.Stdout })
+
+	keepPolicy := KeepAny(KeepTagged("keep", ""), KeepUnlessNamePrefixed("tf-acc-test"))
+	testCases := map[string]struct {
+		options    Options
+		metadata   Metadata
+		wantSkip   bool
+		wantReport string
+	}{
+		"no policy": {
+			metadata: Metadata{ID: "1"},
+		},
+		"deleted": {
+			options:  Options{KeepPolicy: keepPolicy},
+			metadata: Metadata{ID: "1", Name: "tf-acc-test-1", Tags: map[string]string{}},
+		},
+		"kept by name": {
+			options:  Options{KeepPolicy: keepPolicy},
+			metadata: Metadata{ID: "2", Name: "production", Tags: map[string]string{}},
+			wantSkip: true,
+		},
+		"kept with unknown tags": {
+			options:  Options{KeepPolicy: keepPolicy},
+			metadata: Metadata{ID: "3", Name: "tf-acc-test-3"},
+			wantSkip: true,
+		},
+		"dry run deleted": {
+			options:    Options{DryRun: true, KeepPolicy: keepPolicy},
+			metadata:   Metadata{ID: "1", Name: "tf-acc-test-1", Tags: map[string]string{}},
+			wantSkip:   true,
+			wantReport: "[DRY RUN] us-west-2: would delete 1\n",
+		},
+		"dry run kept": {
+			options:    Options{DryRun: true, KeepPolicy: keepPolicy},
+			metadata:   Metadata{ID: "2", Name: "production", Tags: map[string]string{}},
+			wantSkip:   true,
+			wantReport: "[DRY RUN] us-west-2: would keep 2 (production): name \"production\" not in prefix allow-list\n",
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Overrides reportWriter.
+		t.Run(name, func(t *testing.T) {
+			sb.Reset()
+			ctx := WithOptions(Context("us-west-2"), testCase.options)
+
+			if got, want := SkipResourceAction(ctx, testCase.metadata, "delete %s", testCase.metadata.ID), testCase.wantSkip; got != want {
+				t.Errorf("SkipResourceAction = %t, want %t", got, want)
+			}
+			if got, want := sb.String(), testCase.wantReport; got != want {
+				t.Errorf("report = %q, want %q", got, want)
+			}
+		})
+	}
+}
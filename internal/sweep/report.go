@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// reportWriter is overridden in unit tests.
+var reportWriter io.Writer = os.Stdout
+
+type reportEntry struct {
+	metadata Metadata
+	reason   string
+}
+
+// dryRunReport records what a single sweeper would do in a single Region.
+type dryRunReport struct {
+	region       string
+	resourceType string
+	deleted      []reportEntry
+	kept         []reportEntry
+}
+
+func newDryRunReport(ctx context.Context) *dryRunReport {
+	return &dryRunReport{
+		region:       regionFromContext(ctx),
+		resourceType: resourceTypeFromContext(ctx),
+	}
+}
+
+func (r *dryRunReport) delete(m Metadata) {
+	r.deleted = append(r.deleted, reportEntry{metadata: m})
+}
+
+func (r *dryRunReport) keep(m Metadata, reason string) {
+	r.kept = append(r.kept, reportEntry{metadata: m, reason: reason})
+}
+
+func (r *dryRunReport) write(w io.Writer) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "[DRY RUN] %s", valueOrUnknown(r.region))
+	if r.resourceType != "" {
+		fmt.Fprintf(&sb, " %s", r.resourceType)
+	}
+	fmt.Fprintf(&sb, ": would delete %d, would keep %d\n", len(r.deleted), len(r.kept))
+
+	for _, e := range r.deleted {
+		fmt.Fprintf(&sb, "  delete %s\n", e.metadata.String())
+	}
+	for _, e := range r.kept {
+		fmt.Fprintf(&sb, "  keep   %s: %s\n", e.metadata.String(), e.reason)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+func valueOrUnknown(s string) string {
+	if s == "" {
+		return "<unknown>"
+	}
+
+	return s
+}
+
+// SkipForDryRun returns true if sweepers are running in dry-run mode, reporting the specified action
+// instead of it being performed.
+// Actions on individual resources must use SkipResourceAction instead, so that the configured KeepPolicy is applied.
+// If the sweeper options cannot be determined the action is also skipped.
+func SkipForDryRun(ctx context.Context, format string, a ...any) bool {
+	options, err := optionsFromContext(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Skipping action, sweeper options", map[string]any{
+			"error": err.Error(),
+		})
+		return true
+	}
+
+	if !options.DryRun {
+		return false
+	}
+
+	fmt.Fprintf(reportWriter, "[DRY RUN] %s: would %s\n", valueOrUnknown(regionFromContext(ctx)), fmt.Sprintf(format, a...))
+
+	return true
+}
+
+// SkipResourceAction returns true if an action on the resource described by m must be skipped,
+// either because the configured KeepPolicy keeps the resource or because sweepers are running in dry-run mode.
+// Sweepers that delete or modify individual resources without using SweepOrchestrator must call SkipResourceAction
+// before each such API call, including calls that prepare a resource for deletion by SweepOrchestrator.
+// If the context carries a rate limiter (see WithRateLimiter) SkipResourceAction waits on it before returning false,
+// and returns true if the context is done while waiting.
+func SkipResourceAction(ctx context.Context, m Metadata, format string, a ...any) bool {
+	options, err := optionsFromContext(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Skipping action, sweeper options", map[string]any{
+			"error": err.Error(),
+		})
+		return true
+	}
+
+	if options.KeepPolicy != nil {
+		if keep, reason := options.KeepPolicy.Keep(m); keep {
+			tflog.Info(ctx, "Keeping resource", map[string]any{
+				"id":     m.ID,
+				"reason": reason,
+			})
+			if options.DryRun {
+				fmt.Fprintf(reportWriter, "[DRY RUN] %s: would keep %s: %s\n", valueOrUnknown(regionFromContext(ctx)), m.String(), reason)
+			}
+			return true
+		}
+	}
+
+	if SkipForDryRun(ctx, format, a...) {
+		return true
+	}
+
+	if limiter := rateLimiterFromContext(ctx); limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			tflog.Warn(ctx, "Skipping action", map[string]any{
+				"id":    m.ID,
+				"error": err.Error(),
+			})
+			return true
+		}
+	}
+
+	return false
+}
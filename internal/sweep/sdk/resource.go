@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +41,30 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Metadata describes the resource using any name and tags set on its ResourceData.
+// Tags are unknown (nil) unless a non-empty tags value has been set.
+func (sr *sweepResource) Metadata(ctx context.Context) metadata.Metadata {
+	m := metadata.Metadata{
+		ID: sr.d.Id(),
+	}
+	schemaMap := sr.resource.SchemaMap()
+
+	if v, ok := schemaMap[names.AttrName]; ok && v.Type == schema.TypeString {
+		m.Name = sr.d.Get(names.AttrName).(string)
+	}
+
+	if v, ok := schemaMap[names.AttrTags]; ok && v.Type == schema.TypeMap {
+		if tags := sr.d.Get(names.AttrTags).(map[string]any); len(tags) > 0 {
+			m.Tags = make(map[string]string, len(tags))
+			for k, v := range tags {
+				m.Tags[k], _ = v.(string)
+			}
+		}
+	}
+
+	return m
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type deletedIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (d *deletedIDs) add(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.ids = append(d.ids, id)
+}
+
+func testResource(deleted *deletedIDs) *schema.Resource {
+	return &schema.Resource{
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			deleted.add(d.Id())
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func TestSweepResource_keepPolicies(t *testing.T) {
+	t.Parallel()
+
+	var deleted deletedIDs
+	r := testResource(&deleted)
+	client := &conns.AWSClient{}
+	var sweepables []sweep.Sweepable
+
+	// Only the ID is known, as for most sweepers.
+	d := r.Data(nil)
+	d.SetId("unknown")
+	sweepables = append(sweepables, sdk.NewSweepResource(r, d, client))
+
+	// Tags are set on the ResourceData.
+	d = r.Data(nil)
+	d.SetId("tagged")
+	d.Set(names.AttrTags, map[string]any{"keep": "true"})
+	sweepables = append(sweepables, sweep.NewDescribedSweepable(sdk.NewSweepResource(r, d, client), sweep.Metadata{
+		CreatedAt: time.Now().Add(-48 * time.Hour),
+	}))
+
+	// Tags and creation time are known from the list API.
+	d = r.Data(nil)
+	d.SetId("untagged")
+	sweepables = append(sweepables, sweep.NewDescribedSweepable(sdk.NewSweepResource(r, d, client), sweep.Metadata{
+		Tags:      map[string]string{},
+		CreatedAt: time.Now().Add(-48 * time.Hour),
+	}))
+
+	d = r.Data(nil)
+	d.SetId("new")
+	sweepables = append(sweepables, sweep.NewDescribedSweepable(sdk.NewSweepResource(r, d, client), sweep.Metadata{
+		Tags:      map[string]string{},
+		CreatedAt: time.Now(),
+	}))
+
+	ctx := sweep.WithOptions(sweep.Context("us-west-2"), sweep.Options{ //nolint:contextcheck // Sweeper context.
+		KeepPolicy: sweep.KeepAny(sweep.KeepTagged("keep", ""), sweep.KeepNewerThan(24*time.Hour)),
+	})
+
+	if err := sweep.SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := deleted.ids, []string{"untagged"}; len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("deleted %v, want %v", got, want)
+	}
+}
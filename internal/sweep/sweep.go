@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -27,12 +29,19 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
-// sweeperClients is a shared cache of regional conns.AWSClient
-// This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	// sweeperClients is a shared cache of regional conns.AWSClient
+	// This prevents client re-initialization for every resource with no benefit.
+	sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	// sweeperClientsMu guards sweeperClients when sweepers run concurrently.
+	sweeperClientsMu sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -104,20 +113,136 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// Resources kept by the configured KeepPolicy are skipped and in dry-run mode nothing is deleted;
+// instead a report of what would be deleted is written to standard output.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	_, err := Orchestrate(ctx, sweepables, optFns...)
+
+	return err
+}
+
+// Stats counts the outcomes of a single call to Orchestrate.
+// In dry-run mode Deleted counts the resources that would have been deleted.
+type Stats struct {
+	Deleted int
+	Kept    int
+	Failed  int
+}
+
+// StatsCollector accumulates the Stats of every call to Orchestrate made with a context returned by WithStatsCollector.
+type StatsCollector struct {
+	mu    sync.Mutex
+	stats Stats
+}
+
+func (c *StatsCollector) add(stats Stats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.Deleted += stats.Deleted
+	c.stats.Kept += stats.Kept
+	c.stats.Failed += stats.Failed
+}
+
+// Stats returns the accumulated counts.
+func (c *StatsCollector) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+type statsCollectorKey struct{}
+
+// WithStatsCollector returns a context whose sweeps add their counts to the specified StatsCollector.
+func WithStatsCollector(ctx context.Context, c *StatsCollector) context.Context {
+	return context.WithValue(ctx, statsCollectorKey{}, c)
+}
+
+func statsCollectorFromContext(ctx context.Context) *StatsCollector {
+	v, _ := ctx.Value(statsCollectorKey{}).(*StatsCollector)
+
+	return v
+}
+
+// Orchestrate is SweepOrchestrator, additionally returning counts of deleted, kept and failed resources.
+// If the context carries a rate limiter (see WithRateLimiter) each Delete call waits on it,
+// and if it carries a StatsCollector (see WithStatsCollector) the counts are also added to it.
+func Orchestrate(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) (Stats, error) {
+	var stats Stats
+
+	options, err := optionsFromContext(ctx)
+	if err != nil {
+		return stats, err
+	}
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
 	var g multierror.Group
+	var deleted, failed atomic.Int32
+	limiter := rateLimiterFromContext(ctx)
+	report := newDryRunReport(ctx)
 
 	for _, sweepable := range sweepables {
+		if options.KeepPolicy != nil || options.DryRun {
+			m := Describe(ctx, sweepable)
+
+			if options.KeepPolicy != nil {
+				if keep, reason := options.KeepPolicy.Keep(m); keep {
+					tflog.Info(ctx, "Keeping resource", map[string]any{
+						"id":     m.ID,
+						"reason": reason,
+					})
+					report.keep(m, reason)
+					stats.Kept++
+					continue
+				}
+			}
+
+			if options.DryRun {
+				report.delete(m)
+				stats.Deleted++
+				continue
+			}
+		}
+
 		g.Go(func() error {
-			return sweepable.Delete(ctx, optFns...)
+			if limiter != nil {
+				if err := limiter.Wait(ctx); err != nil {
+					failed.Add(1)
+					return err
+				}
+			}
+
+			if err := sweepable.Delete(ctx, optFns...); err != nil {
+				failed.Add(1)
+				return err
+			}
+
+			deleted.Add(1)
+			return nil
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	if options.DryRun {
+		if c := statsCollectorFromContext(ctx); c != nil {
+			c.add(stats)
+		}
+
+		return stats, report.write(reportWriter)
+	}
+
+	err = g.Wait().ErrorOrNil()
+	stats.Deleted, stats.Failed = int(deleted.Load()), int(failed.Load())
+
+	if c := statsCollectorFromContext(ctx); c != nil {
+		c.add(stats)
+	}
+
+	return stats, err
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -5,10 +5,22 @@ package sweep_test
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+var (
+	flagSweepParallelism = flag.Int("sweep-parallelism", 0, "run sweepers registered with awsv2.Register concurrently, in dependency order, using up to this many workers")
+	flagSweepRateLimit   = flag.Float64("sweep-rate-limit", 0, "with -sweep-parallelism, the maximum number of deletions started per second for each service in each region")
+	flagSweepSummary     = flag.String("sweep-summary", "", "with -sweep-parallelism, the file to write the JSON sweep summary to (default standard output)")
 )
 
 func TestMain(m *testing.M) {
@@ -18,5 +30,78 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+	if *flagSweepParallelism > 0 {
+		os.Exit(runSweepers(ctx))
+	}
+
 	resource.TestMain(m)
 }
+
+// runSweepers runs sweepers using the native, concurrent runner instead of the plugin-testing runner.
+// The -sweep, -sweep-run and -sweep-allow-failures flags registered by plugin-testing are honored.
+func runSweepers(ctx context.Context) int {
+	options := awsv2.RunOptions{
+		Parallelism: *flagSweepParallelism,
+		RateLimit:   *flagSweepRateLimit,
+	}
+
+	if v := flag.Lookup("sweep"); v != nil {
+		options.Regions = splitFlagValue(v.Value.String())
+	}
+	if len(options.Regions) == 0 {
+		fmt.Fprintln(os.Stderr, "-sweep must specify at least one region")
+		return 1
+	}
+	if v := flag.Lookup("sweep-run"); v != nil {
+		options.Sweepers = splitFlagValue(v.Value.String())
+	}
+	if v := flag.Lookup("sweep-allow-failures"); v != nil {
+		options.AllowFailures = v.Value.String() == "true"
+	}
+
+	summary, err := awsv2.Run(ctx, options)
+
+	if summary != nil {
+		if err := writeSummary(summary); err != nil {
+			fmt.Fprintf(os.Stderr, "writing sweep summary: %s\n", err)
+			return 1
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sweeping: %s\n", err)
+		if summary == nil || !options.AllowFailures {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func writeSummary(summary *awsv2.Summary) error {
+	output, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	output = append(output, '\n')
+
+	if *flagSweepSummary == "" {
+		_, err = os.Stdout.Write(output)
+		return err
+	}
+
+	return os.WriteFile(*flagSweepSummary, output, 0600)
+}
+
+func splitFlagValue(s string) []string {
+	var values []string
+
+	for v := range strings.SplitSeq(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
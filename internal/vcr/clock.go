@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock tracks time for code that waits between polls of a resource's state.
+// It satisfies backoff.Clock.
+type Clock interface {
+	After(time.Duration) <-chan time.Time
+	Now() time.Time
+}
+
+// ClockFunc returns a new Clock.
+type ClockFunc func() Clock
+
+// ReplayClock is a Clock that skips time rather than waiting for it to pass.
+//
+// When replaying recorded interactions there is no need to wait between polls of a resource's state.
+// Waiting on a ReplayClock advances its time immediately, so waiters observe the same delays and
+// timeouts as when the interactions were recorded without actually sleeping.
+//
+// Time skipped by one waiter must not count towards the timeouts of other waiters,
+// so each waiter should use its own ReplayClock.
+type ReplayClock struct {
+	mu     sync.Mutex
+	offset time.Duration
+}
+
+// NewReplayClock returns a ReplayClock starting at the current time.
+func NewReplayClock() *ReplayClock {
+	return &ReplayClock{}
+}
+
+// After advances the clock by d and returns a channel on which the new time is immediately available.
+func (c *ReplayClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	if d > 0 {
+		c.offset += d
+	}
+	now := time.Now().Add(c.offset)
+	c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	ch <- now
+
+	return ch
+}
+
+// Now returns the current time, including any time skipped.
+func (c *ReplayClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return time.Now().Add(c.offset)
+}
+
+// Skipped returns the total time skipped.
+func (c *ReplayClock) Skipped() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.offset
+}
+
+type clockContextKeyType int
+
+var clockContextKey clockContextKeyType
+
+// NewContextWithClockFunc returns a Context carrying the specified ClockFunc.
+func NewContextWithClockFunc(ctx context.Context, f ClockFunc) context.Context {
+	return context.WithValue(ctx, clockContextKey, f)
+}
+
+// NewClockFromContext returns a new Clock from the ClockFunc carried in a Context, if any.
+// Each waiter should call NewClockFromContext once and use the returned Clock for all of its waits.
+func NewClockFromContext(ctx context.Context) (Clock, bool) {
+	f, ok := ctx.Value(clockContextKey).(ClockFunc)
+	if !ok || f == nil {
+		return nil, false
+	}
+
+	return f(), true
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func TestReplayClock(t *testing.T) {
+	t.Parallel()
+
+	clock := vcr.NewReplayClock()
+	start := time.Now()
+
+	select {
+	case <-clock.After(10 * time.Minute):
+	case <-time.After(1 * time.Second):
+		t.Fatal("ReplayClock.After did not return immediately")
+	}
+
+	if got, want := clock.Skipped(), 10*time.Minute; got != want {
+		t.Errorf("Skipped() = %v, want %v", got, want)
+	}
+	if got := clock.Now().Sub(start); got < 10*time.Minute {
+		t.Errorf("Now() advanced %v, want at least 10m", got)
+	}
+}
+
+func TestNewClockFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if _, ok := vcr.NewClockFromContext(ctx); ok {
+		t.Error("NewClockFromContext() returned a clock for a Context without a ClockFunc")
+	}
+
+	ctx = vcr.NewContextWithClockFunc(ctx, func() vcr.Clock {
+		return vcr.NewReplayClock()
+	})
+
+	clock1, ok := vcr.NewClockFromContext(ctx)
+	if !ok {
+		t.Fatal("NewClockFromContext() returned no clock")
+	}
+	clock2, ok := vcr.NewClockFromContext(ctx)
+	if !ok {
+		t.Fatal("NewClockFromContext() returned no clock")
+	}
+	if clock1 == clock2 {
+		t.Fatal("NewClockFromContext() returned the same clock twice")
+	}
+
+	<-clock1.After(10 * time.Minute)
+
+	if got := clock2.(*vcr.ReplayClock).Skipped(); got != 0 {
+		t.Errorf("Skipped() = %v for an unused clock, want 0", got)
+	}
+}
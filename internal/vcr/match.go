@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	// ignoredFields are request fields whose values vary between otherwise identical requests,
+	// keyed by service signing name. Fields keyed by "" are ignored for all services.
+	ignoredFields = map[string][]string{
+		"": {
+			"ClientRequestToken",
+			"ClientToken",
+			"IdempotencyToken",
+			"clientRequestToken",
+			"clientToken",
+			"idempotencyToken",
+		},
+		"cloudfront": {
+			"CallerReference",
+		},
+		"logs": {
+			"endTime",
+			"startTime",
+		},
+		"monitoring": {
+			"EndTime",
+			"StartTime",
+		},
+		"route53": {
+			"CallerReference",
+		},
+	}
+	ignoredFieldsMu sync.Mutex
+)
+
+// AddIgnoredFields adds request fields that are ignored when matching the requests of the specified service
+// with recorded requests. The service is identified by its signing name, e.g. "ec2" or "monitoring".
+//
+// For JSON bodies a field is an object key, for form bodies a parameter name or the last component of
+// a flattened parameter name such as "Filter.1.Name", and for XML bodies an element name.
+func AddIgnoredFields(service string, fields ...string) {
+	ignoredFieldsMu.Lock()
+	defer ignoredFieldsMu.Unlock()
+
+	ignoredFields[service] = append(ignoredFields[service], fields...)
+}
+
+func ignoredFieldsFor(service string) map[string]struct{} {
+	ignoredFieldsMu.Lock()
+	defer ignoredFieldsMu.Unlock()
+
+	fields := make(map[string]struct{})
+	for _, field := range ignoredFields[""] {
+		fields[field] = struct{}{}
+	}
+	if service != "" {
+		for _, field := range ignoredFields[service] {
+			fields[field] = struct{}{}
+		}
+	}
+
+	return fields
+}
+
+// RequestBodiesMatch returns whether the body of a live request is semantically equal to the body of a recorded request.
+//
+// Bodies are compared according to the request's AWS protocol:
+//
+//   - awsJson1_0, awsJson1_1 and restJson1 bodies are compared independently of object key order
+//   - awsQuery and ec2Query form bodies are compared independently of parameter order
+//   - restXml bodies are compared independently of attribute order and insignificant whitespace
+//
+// Fields registered with AddIgnoredFields, such as idempotency tokens, are removed before comparison.
+// Bodies with a missing, invalid or unsupported Content-Type are compared byte for byte.
+// An error is returned if either body cannot be parsed.
+func RequestBodiesMatch(r *http.Request, body, recordedBody string) (bool, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return body == recordedBody, nil
+	}
+
+	ignored := ignoredFieldsFor(signingName(r))
+
+	// https://smithy.io/2.0/aws/protocols/index.html.
+	switch {
+	case mediaType == "application/json", strings.HasPrefix(mediaType, "application/x-amz-json-"):
+		return bodiesMatch(body, recordedBody, ignored, normalizeJSON)
+	case mediaType == "application/x-www-form-urlencoded":
+		return bodiesMatch(body, recordedBody, ignored, normalizeForm)
+	case mediaType == "application/xml", mediaType == "text/xml":
+		return bodiesMatch(body, recordedBody, ignored, normalizeXML)
+	}
+
+	return body == recordedBody, nil
+}
+
+func bodiesMatch(body, recordedBody string, ignored map[string]struct{}, normalize func(string, map[string]struct{}) (any, error)) (bool, error) {
+	v1, err := normalize(body, ignored)
+	if err != nil {
+		return false, fmt.Errorf("request: %w", err)
+	}
+
+	v2, err := normalize(recordedBody, ignored)
+	if err != nil {
+		return false, fmt.Errorf("cassette: %w", err)
+	}
+
+	return reflect.DeepEqual(v1, v2), nil
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/,]+/\d{8}/[^/]+/([^/]+)/aws4_request`)
+
+// signingName returns the SigV4 signing name of a request, falling back to the first label of its host.
+func signingName(r *http.Request) string {
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		return m[1]
+	}
+
+	if r.URL == nil {
+		return ""
+	}
+
+	host, _, _ := strings.Cut(r.URL.Hostname(), ".")
+
+	return host
+}
+
+func normalizeJSON(body string, ignored map[string]struct{}) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return nil, err
+	}
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, child := range v {
+				if _, ok := ignored[k]; ok {
+					delete(v, k)
+					continue
+				}
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(v)
+
+	return v, nil
+}
+
+func normalizeForm(body string, ignored map[string]struct{}) (any, error) {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range values {
+		parts := strings.Split(k, ".")
+		if _, ok := ignored[parts[len(parts)-1]]; ok {
+			delete(values, k)
+		}
+	}
+
+	return values, nil
+}
+
+// xmlElement is a generic representation of an XML element.
+type xmlElement struct {
+	Name     string
+	Attrs    []string
+	Text     string
+	Children []*xmlElement
+}
+
+func normalizeXML(body string, ignored map[string]struct{}) (any, error) {
+	root := &xmlElement{}
+	stack := []*xmlElement{root}
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{
+				Name: token.Name.Local,
+			}
+			for _, attr := range token.Attr {
+				element.Attrs = append(element.Attrs, attr.Name.Space+":"+attr.Name.Local+"="+attr.Value)
+			}
+			slices.Sort(element.Attrs)
+
+			if _, ok := ignored[element.Name]; !ok {
+				parent.Children = append(parent.Children, element)
+			}
+			stack = append(stack, element)
+		case xml.CharData:
+			parent.Text += string(token)
+		case xml.EndElement:
+			parent.Text = strings.TrimSpace(parent.Text)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return root.Children, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func TestRequestBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	vcr.AddIgnoredFields("testservice", "RequestTime")
+
+	testCases := map[string]struct {
+		url           string
+		contentType   string
+		authorization string
+		body          string
+		recordedBody  string
+		want          bool
+		wantErr       bool
+	}{
+		"JSON reordered": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"logGroupName":"test","tags":{"a":"1","b":"2"}}`,
+			recordedBody: `{"tags":{"b":"2","a":"1"},"logGroupName":"test"}`,
+			want:         true,
+		},
+		"JSON different": {
+			contentType:  "application/x-amz-json-1.0",
+			body:         `{"TableName":"test1"}`,
+			recordedBody: `{"TableName":"test2"}`,
+		},
+		"JSON client token": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Name":"test","ClientToken":"a1b2c3","Settings":[{"clientToken":"d4e5f6"}]}`,
+			recordedBody: `{"Settings":[{"clientToken":"f6e5d4"}],"ClientToken":"c3b2a1","Name":"test"}`,
+			want:         true,
+		},
+		"JSON array order": {
+			contentType:  "application/json",
+			body:         `{"Items":["a","b"]}`,
+			recordedBody: `{"Items":["b","a"]}`,
+		},
+		"JSON invalid": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Name":"test"}`,
+			recordedBody: `{"Name":`,
+			wantErr:      true,
+		},
+		"service ignored field from signing name": {
+			url:           "https://example.us-west-2.amazonaws.com/",
+			contentType:   "application/x-amz-json-1.1",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE000000001/20240101/us-west-2/testservice/aws4_request, SignedHeaders=host, Signature=abc",
+			body:          `{"Name":"test","RequestTime":1700000000}`,
+			recordedBody:  `{"Name":"test","RequestTime":1600000000}`,
+			want:          true,
+		},
+		"service ignored field from host": {
+			url:          "https://testservice.us-west-2.amazonaws.com/",
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Name":"test","RequestTime":1700000000}`,
+			recordedBody: `{"Name":"test","RequestTime":1600000000}`,
+			want:         true,
+		},
+		"service ignored field other service": {
+			url:          "https://otherservice.us-west-2.amazonaws.com/",
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Name":"test","RequestTime":1700000000}`,
+			recordedBody: `{"Name":"test","RequestTime":1600000000}`,
+		},
+		"query reordered": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			recordedBody: "Version=2016-11-15&Action=CreateVpc&CidrBlock=10.0.0.0%2F16",
+			want:         true,
+		},
+		"query client token": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=RunInstances&ClientToken=a1b2c3&ImageId=ami-12345678&Version=2016-11-15",
+			recordedBody: "Action=RunInstances&ClientToken=c3b2a1&ImageId=ami-12345678&Version=2016-11-15",
+			want:         true,
+		},
+		"query flattened ignored field": {
+			url:          "https://monitoring.us-west-2.amazonaws.com/",
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=GetMetricData&StartTime=2024-01-01T00%3A00%3A00Z&MetricDataQueries.member.1.Id=m1",
+			recordedBody: "Action=GetMetricData&StartTime=2023-01-01T00%3A00%3A00Z&MetricDataQueries.member.1.Id=m1",
+			want:         true,
+		},
+		"query different": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=DescribeVpcs&VpcId.1=vpc-1&Version=2016-11-15",
+			recordedBody: "Action=DescribeVpcs&VpcId.1=vpc-2&Version=2016-11-15",
+		},
+		"XML whitespace and attribute order": {
+			contentType:  "application/xml",
+			body:         `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/" a="1"><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>`,
+			recordedBody: "<Tagging a=\"1\" xmlns=\"http://s3.amazonaws.com/doc/2006-03-01/\">\n  <TagSet>\n    <Tag><Key>k</Key><Value>v</Value></Tag>\n  </TagSet>\n</Tagging>",
+			want:         true,
+		},
+		"XML caller reference": {
+			url:          "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			contentType:  "application/xml",
+			body:         `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>1</CallerReference></CreateHostedZoneRequest>`,
+			recordedBody: `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>2</CallerReference></CreateHostedZoneRequest>`,
+			want:         true,
+		},
+		"XML different": {
+			contentType:  "application/xml",
+			body:         `<Tagging><TagSet><Tag><Key>k</Key><Value>v1</Value></Tag></TagSet></Tagging>`,
+			recordedBody: `<Tagging><TagSet><Tag><Key>k</Key><Value>v2</Value></Tag></TagSet></Tagging>`,
+		},
+		"XML invalid": {
+			contentType:  "application/xml",
+			body:         `<Tagging></Tagging>`,
+			recordedBody: `<Tagging>`,
+			wantErr:      true,
+		},
+		"unsupported content type": {
+			contentType:  "application/octet-stream",
+			body:         "a",
+			recordedBody: "b",
+		},
+		"unsupported content type equal": {
+			contentType:  "application/octet-stream",
+			body:         "a",
+			recordedBody: "a",
+			want:         true,
+		},
+		"invalid content type": {
+			contentType:  "application/json; charset",
+			body:         `{"a":1,"b":2}`,
+			recordedBody: `{"b":2,"a":1}`,
+		},
+		"invalid content type equal": {
+			contentType:  "application/json; charset",
+			body:         `{"a":1}`,
+			recordedBody: `{"a":1}`,
+			want:         true,
+		},
+		"missing content type equal": {
+			body:         "Action=DescribeVpcs",
+			recordedBody: "Action=DescribeVpcs",
+			want:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			url := testCase.url
+			if url == "" {
+				url = "https://example.us-west-2.amazonaws.com/"
+			}
+			r, err := http.NewRequest(http.MethodPost, url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			r.Header.Set("Content-Type", testCase.contentType)
+			if testCase.authorization != "" {
+				r.Header.Set("Authorization", testCase.authorization)
+			}
+
+			got, err := vcr.RequestBodiesMatch(r, testCase.body, testCase.recordedBody)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("RequestBodiesMatch() err %t, want %t: %v", got, want, err)
+			}
+			if err == nil {
+				if got != testCase.want {
+					t.Errorf("RequestBodiesMatch() = %t, want %t", got, testCase.want)
+				}
+			}
+		})
+	}
+}
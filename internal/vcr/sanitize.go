@@ -0,0 +1,679 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// Placeholder describes how sensitive values of one kind are replaced in recorded interactions.
+//
+// Placeholders are derived from a hash of the value they replace, so a value always has the same placeholder
+// regardless of the order in which values are seen. This keeps replayed requests matching their recordings.
+type Placeholder struct {
+	name    string
+	format  func(h uint64) string
+	pattern *regexp.Regexp
+}
+
+// NewPlaceholder returns a Placeholder producing values of the form REDACTED_<NAME>_<hash>.
+func NewPlaceholder(name string) Placeholder {
+	name = strings.ToUpper(name)
+
+	return Placeholder{
+		name: name,
+		format: func(h uint64) string {
+			return fmt.Sprintf("REDACTED_%s_%08x", name, uint32(h))
+		},
+		pattern: regexp.MustCompile(fmt.Sprintf(`^REDACTED_%s_[0-9a-f]{8}$`, regexp.QuoteMeta(name))),
+	}
+}
+
+// hash returns the hash of a value, for use as the basis of its placeholder.
+// A non-zero attempt returns an alternative hash, used if an earlier hash collides with that of another value.
+func (p Placeholder) hash(v string, attempt int) uint64 {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%s\x00%d", p.name, v, attempt))
+
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+func (p Placeholder) isPlaceholder(v string) bool {
+	return p.pattern.MatchString(v)
+}
+
+var (
+	// PlaceholderAccountID replaces AWS account IDs with 12-digit values starting with six zeros, e.g. 000000481516.
+	PlaceholderAccountID = Placeholder{
+		name: "ACCOUNT_ID",
+		format: func(h uint64) string {
+			return fmt.Sprintf("%012d", h%1_000_000)
+		},
+		pattern: regexp.MustCompile(`^0{6}\d{6}$`),
+	}
+
+	// PlaceholderAccessKeyID replaces AWS access key IDs with values of the form AKIAEXAMPLE123456789.
+	PlaceholderAccessKeyID = Placeholder{
+		name: "ACCESS_KEY_ID",
+		format: func(h uint64) string {
+			return fmt.Sprintf("AKIAEXAMPLE%09d", h%1_000_000_000)
+		},
+		pattern: regexp.MustCompile(`^AKIAEXAMPLE\d{9}$`),
+	}
+
+	// PlaceholderIPAddress replaces IPv4 addresses with addresses from the documentation ranges (RFC 5737).
+	PlaceholderIPAddress = Placeholder{
+		name: "IP_ADDRESS",
+		format: func(h uint64) string {
+			prefixes := []string{"192.0.2", "198.51.100", "203.0.113"}
+			n := h % uint64(254*len(prefixes))
+
+			return fmt.Sprintf("%s.%d", prefixes[n/254], n%254+1)
+		},
+		pattern: regexp.MustCompile(`^(192\.0\.2|198\.51\.100|203\.0\.113)\.\d{1,3}$|^REDACTED_IP_ADDRESS_[0-9a-f]{8}$`),
+	}
+
+	// PlaceholderSecret replaces secret values such as session tokens and secret access keys.
+	PlaceholderSecret = NewPlaceholder("SECRET")
+)
+
+type sanitizationRuleKind int
+
+const (
+	sanitizationRuleKindHeader sanitizationRuleKind = iota
+	sanitizationRuleKindQuery
+	sanitizationRuleKindJSONPath
+	sanitizationRuleKindXMLPath
+	sanitizationRuleKindRegexp
+)
+
+// SanitizationRule identifies sensitive values in recorded interactions.
+type SanitizationRule struct {
+	kind        sanitizationRuleKind
+	name        string
+	path        []string
+	re          *regexp.Regexp
+	skip        func(string) bool
+	placeholder Placeholder
+}
+
+// HeaderRule redacts the values of the named request and response header.
+func HeaderRule(name string, placeholder Placeholder) SanitizationRule {
+	return SanitizationRule{
+		kind:        sanitizationRuleKindHeader,
+		name:        http.CanonicalHeaderKey(name),
+		placeholder: placeholder,
+	}
+}
+
+// QueryRule redacts the values of the named URL query or form body parameter.
+func QueryRule(name string, placeholder Placeholder) SanitizationRule {
+	return SanitizationRule{
+		kind:        sanitizationRuleKindQuery,
+		name:        name,
+		placeholder: placeholder,
+	}
+}
+
+// JSONPathRule redacts string values in JSON bodies whose key path ends with path.
+// Array elements do not contribute to the key path.
+func JSONPathRule(placeholder Placeholder, path ...string) SanitizationRule {
+	return SanitizationRule{
+		kind:        sanitizationRuleKindJSONPath,
+		path:        path,
+		placeholder: placeholder,
+	}
+}
+
+// XMLPathRule redacts the character data of elements in XML bodies whose element path ends with path.
+func XMLPathRule(placeholder Placeholder, path ...string) SanitizationRule {
+	return SanitizationRule{
+		kind:        sanitizationRuleKindXMLPath,
+		path:        path,
+		placeholder: placeholder,
+	}
+}
+
+// RegexpRule redacts matches of expr in URLs, header values and bodies.
+// If expr contains a capturing group, only the text matched by the first group is redacted.
+func RegexpRule(expr string, placeholder Placeholder) SanitizationRule {
+	return SanitizationRule{
+		kind:        sanitizationRuleKindRegexp,
+		re:          regexp.MustCompile(expr),
+		placeholder: placeholder,
+	}
+}
+
+var (
+	sanitizationRules = []SanitizationRule{
+		RegexpRule(`arn:aws[a-z-]*:[a-z0-9-]*:[a-z0-9-]*:(\d{12}):`, PlaceholderAccountID),
+		RegexpRule(`arn%3Aaws[a-z-]*%3A[a-z0-9-]*%3A[a-z0-9-]*%3A(\d{12})%3A`, PlaceholderAccountID),
+		RegexpRule(`//(\d{12})\.s3-control`, PlaceholderAccountID),
+		RegexpRule(`\b((?:AKIA|ASIA)[A-Z0-9]{16})\b`, PlaceholderAccessKeyID),
+		ipAddressRule(),
+		HeaderRule("X-Amz-Account-Id", PlaceholderAccountID),
+		QueryRule("X-Amz-Security-Token", PlaceholderSecret),
+		QueryRule("X-Amz-Signature", PlaceholderSecret),
+		XMLPathRule(PlaceholderAccountID, "GetCallerIdentityResult", "Account"),
+		XMLPathRule(PlaceholderAccountID, "ownerId"),
+		XMLPathRule(PlaceholderSecret, "Credentials", "SecretAccessKey"),
+		XMLPathRule(PlaceholderSecret, "Credentials", "SessionToken"),
+		JSONPathRule(PlaceholderAccountID, "AccountId"),
+		JSONPathRule(PlaceholderAccountID, "accountId"),
+		JSONPathRule(PlaceholderSecret, "Credentials", "SecretAccessKey"),
+		JSONPathRule(PlaceholderSecret, "Credentials", "SecretKey"),
+		JSONPathRule(PlaceholderSecret, "Credentials", "SessionToken"),
+		JSONPathRule(PlaceholderSecret, "roleCredentials", "secretAccessKey"),
+		JSONPathRule(PlaceholderSecret, "roleCredentials", "sessionToken"),
+		JSONPathRule(PlaceholderSecret, "accessToken"),
+		JSONPathRule(PlaceholderSecret, "refreshToken"),
+		JSONPathRule(PlaceholderSecret, "SecretString"),
+		JSONPathRule(PlaceholderSecret, "SecretBinary"),
+	}
+	sanitizationRulesMu sync.Mutex
+)
+
+// ipAddressRule redacts public IPv4 addresses.
+// Private, loopback, link-local and other special-purpose addresses are left as-is.
+func ipAddressRule() SanitizationRule {
+	rule := RegexpRule(`\b(?:\d{1,3}\.){3}\d{1,3}\b`, PlaceholderIPAddress)
+	rule.skip = func(v string) bool {
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return true
+		}
+
+		return !addr.IsGlobalUnicast() || addr.IsPrivate()
+	}
+
+	return rule
+}
+
+// AddSanitizationRules adds rules applied to all subsequently recorded interactions.
+func AddSanitizationRules(rules ...SanitizationRule) {
+	sanitizationRulesMu.Lock()
+	defer sanitizationRulesMu.Unlock()
+
+	sanitizationRules = append(sanitizationRules, rules...)
+}
+
+// SanitizationRules returns the default sanitization rules along with any added by AddSanitizationRules.
+func SanitizationRules() []SanitizationRule {
+	sanitizationRulesMu.Lock()
+	defer sanitizationRulesMu.Unlock()
+
+	return slices.Clone(sanitizationRules)
+}
+
+// Sanitizer replaces sensitive values in recorded interactions with deterministic placeholders.
+//
+// The placeholder for a value depends only on the value, except in the rare case that two values
+// seen by the same Sanitizer hash to the same placeholder. The later value then gets an alternative placeholder.
+//
+// A Sanitizer remembers the values it has replaced so that placeholders in replayed responses
+// can be restored to the values seen in live requests.
+// A Sanitizer should be used for the interactions of a single cassette.
+type Sanitizer struct {
+	rules []SanitizationRule
+
+	mu           sync.Mutex
+	placeholders map[string]map[string]string // Placeholder name -> value -> placeholder.
+	originals    map[string]string            // Placeholder -> value.
+}
+
+// NewSanitizer returns a Sanitizer applying the specified rules.
+func NewSanitizer(rules ...SanitizationRule) *Sanitizer {
+	return &Sanitizer{
+		rules:        rules,
+		placeholders: make(map[string]map[string]string),
+		originals:    make(map[string]string),
+	}
+}
+
+// SanitizeInteraction redacts sensitive values from a recorded request and response.
+// It is a recorder.HookFunc, intended for use as an AfterCaptureHook and BeforeSaveHook.
+// Sanitizing an interaction more than once has no further effect.
+func (s *Sanitizer) SanitizeInteraction(i *cassette.Interaction) error {
+	i.Request.URL = s.sanitizeURL(i.Request.URL)
+	s.sanitizeHeaders(i.Request.Headers)
+	i.Request.Body = s.sanitizeBody(i.Request.Body, i.Request.Headers.Get("Content-Type"))
+	s.sanitizeForm(i.Request.Form)
+
+	s.sanitizeHeaders(i.Response.Headers)
+	setResponseBody(&i.Response, s.sanitizeBody(i.Response.Body, i.Response.Headers.Get("Content-Type")))
+
+	return nil
+}
+
+// RestoreResponse replaces placeholders in a response with the values they were substituted for.
+// It is a recorder.HookFunc, intended for use as a BeforeResponseReplayHook.
+//
+// Only values this Sanitizer has seen are restored. When recording, that is every redacted value.
+// When replaying, that is every value redacted from live requests by SanitizeRequest.
+func (s *Sanitizer) RestoreResponse(i *cassette.Interaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.originals) == 0 {
+		return nil
+	}
+
+	for _, values := range i.Response.Headers {
+		for j, v := range values {
+			values[j] = s.restore(v, identity)
+		}
+	}
+
+	switch bodyFormatOf(i.Response.Body, i.Response.Headers.Get("Content-Type")) {
+	case bodyFormatJSON:
+		setResponseBody(&i.Response, s.restore(i.Response.Body, jsonEscape))
+	case bodyFormatXML:
+		setResponseBody(&i.Response, s.restore(i.Response.Body, xmlEscape))
+	default:
+		setResponseBody(&i.Response, s.restore(i.Response.Body, identity))
+	}
+
+	return nil
+}
+
+// setResponseBody replaces a response's body, updating its length and any checksum so that clients
+// validating them, such as the DynamoDB client with X-Amz-Crc32, accept the rewritten body.
+func setResponseBody(r *cassette.Response, body string) {
+	if body == r.Body {
+		return
+	}
+
+	r.Body = body
+
+	if r.ContentLength >= 0 {
+		r.ContentLength = int64(len(body))
+	}
+	if r.Headers.Get("Content-Length") != "" {
+		r.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+	if r.Headers.Get("X-Amz-Crc32") != "" {
+		r.Headers.Set("X-Amz-Crc32", strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(body))), 10))
+	}
+}
+
+// SanitizeRequest returns the URL and body of a live request with sensitive values redacted,
+// for comparison with a recorded request.
+func (s *Sanitizer) SanitizeRequest(r *http.Request, body string) (string, string) {
+	return s.sanitizeURL(r.URL.String()), s.sanitizeBody(body, r.Header.Get("Content-Type"))
+}
+
+// restore must be called with s.mu held.
+func (s *Sanitizer) restore(v string, escape func(string) string) string {
+	// Replace longer placeholders first so that 192.0.2.1 does not match a prefix of 192.0.2.10.
+	placeholders := make([]string, 0, len(s.originals))
+	for placeholder := range s.originals {
+		if strings.Contains(v, placeholder) {
+			placeholders = append(placeholders, placeholder)
+		}
+	}
+	if len(placeholders) == 0 {
+		return v
+	}
+
+	slices.SortFunc(placeholders, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	oldnew := make([]string, 0, 2*len(placeholders))
+	for _, placeholder := range placeholders {
+		oldnew = append(oldnew, placeholder, escape(s.originals[placeholder]))
+	}
+
+	return strings.NewReplacer(oldnew...).Replace(v)
+}
+
+// maxPlaceholderAttempts is the number of alternative placeholders tried before falling back to REDACTED_<NAME>_<hash>.
+const maxPlaceholderAttempts = 1000
+
+func (s *Sanitizer) placeholder(p Placeholder, v string) string {
+	if v == "" || p.isPlaceholder(v) {
+		return v
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	placeholders, ok := s.placeholders[p.name]
+	if !ok {
+		placeholders = make(map[string]string)
+		s.placeholders[p.name] = placeholders
+	}
+
+	if placeholder, ok := placeholders[v]; ok {
+		return placeholder
+	}
+
+	var placeholder string
+	for attempt := 0; ; attempt++ {
+		format := p.format
+		if attempt >= maxPlaceholderAttempts {
+			// Placeholders with a small range of values, such as IP addresses, may be exhausted.
+			format = NewPlaceholder(p.name).format
+		}
+
+		placeholder = format(p.hash(v, attempt))
+		if _, ok := s.originals[placeholder]; !ok {
+			break
+		}
+	}
+	placeholders[v] = placeholder
+	s.originals[placeholder] = v
+
+	return placeholder
+}
+
+func (s *Sanitizer) sanitizeString(v string) string {
+	for _, rule := range s.rules {
+		if rule.kind == sanitizationRuleKindRegexp {
+			v = s.replaceRegexp(rule, v)
+		}
+	}
+
+	return v
+}
+
+func (s *Sanitizer) replaceRegexp(rule SanitizationRule, v string) string {
+	matches := rule.re.FindAllStringSubmatchIndex(v, -1)
+	if len(matches) == 0 {
+		return v
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if len(match) > 2 && match[2] >= 0 {
+			start, end = match[2], match[3]
+		}
+
+		value := v[start:end]
+		if rule.placeholder.isPlaceholder(value) || (rule.skip != nil && rule.skip(value)) {
+			continue
+		}
+
+		sb.WriteString(v[last:start])
+		sb.WriteString(s.placeholder(rule.placeholder, value))
+		last = end
+	}
+	sb.WriteString(v[last:])
+
+	return sb.String()
+}
+
+func (s *Sanitizer) sanitizeURL(v string) string {
+	u, err := url.Parse(v)
+	if err != nil {
+		return s.sanitizeString(v)
+	}
+
+	query := u.Query()
+	changed := false
+	for _, rule := range s.rules {
+		if rule.kind != sanitizationRuleKindQuery {
+			continue
+		}
+
+		for j, v := range query[rule.name] {
+			if placeholder := s.placeholder(rule.placeholder, v); placeholder != v {
+				query[rule.name][j] = placeholder
+				changed = true
+			}
+		}
+	}
+	if changed {
+		u.RawQuery = query.Encode()
+		v = u.String()
+	}
+
+	return s.sanitizeString(v)
+}
+
+func (s *Sanitizer) sanitizeHeaders(headers http.Header) {
+	for _, rule := range s.rules {
+		if rule.kind != sanitizationRuleKindHeader {
+			continue
+		}
+
+		for j, v := range headers[rule.name] {
+			headers[rule.name][j] = s.placeholder(rule.placeholder, v)
+		}
+	}
+
+	for _, values := range headers {
+		for j, v := range values {
+			values[j] = s.sanitizeString(v)
+		}
+	}
+}
+
+func (s *Sanitizer) sanitizeForm(form url.Values) {
+	for _, rule := range s.rules {
+		if rule.kind != sanitizationRuleKindQuery {
+			continue
+		}
+
+		for j, v := range form[rule.name] {
+			form[rule.name][j] = s.placeholder(rule.placeholder, v)
+		}
+	}
+
+	for _, values := range form {
+		for j, v := range values {
+			values[j] = s.sanitizeString(v)
+		}
+	}
+}
+
+func (s *Sanitizer) sanitizeBody(body, contentType string) string {
+	if body == "" {
+		return body
+	}
+
+	switch format := bodyFormatOf(body, contentType); format {
+	case bodyFormatJSON, bodyFormatXML:
+		for _, rule := range s.rules {
+			var values []string
+			switch {
+			case format == bodyFormatJSON && rule.kind == sanitizationRuleKindJSONPath:
+				values = jsonValuesAtPath(body, rule.path)
+			case format == bodyFormatXML && rule.kind == sanitizationRuleKindXMLPath:
+				values = xmlValuesAtPath(body, rule.path)
+			}
+
+			for _, v := range values {
+				placeholder := s.placeholder(rule.placeholder, v)
+				if format == bodyFormatJSON {
+					body = strings.ReplaceAll(body, `"`+jsonEscape(v)+`"`, `"`+placeholder+`"`)
+				} else {
+					body = strings.ReplaceAll(body, ">"+xmlEscape(v)+"<", ">"+placeholder+"<")
+				}
+			}
+		}
+
+	case bodyFormatForm:
+		body = s.sanitizeFormBody(body)
+	}
+
+	return s.sanitizeString(body)
+}
+
+// sanitizeFormBody redacts parameters in a URL-encoded form body, preserving parameter order.
+func (s *Sanitizer) sanitizeFormBody(body string) string {
+	pairs := strings.Split(body, "&")
+	changed := false
+
+	for _, rule := range s.rules {
+		if rule.kind != sanitizationRuleKindQuery {
+			continue
+		}
+
+		for j, pair := range pairs {
+			k, v, _ := strings.Cut(pair, "=")
+			if name, err := url.QueryUnescape(k); err != nil || name != rule.name {
+				continue
+			}
+			value, err := url.QueryUnescape(v)
+			if err != nil {
+				continue
+			}
+
+			if placeholder := s.placeholder(rule.placeholder, value); placeholder != value {
+				pairs[j] = k + "=" + url.QueryEscape(placeholder)
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return body
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+type bodyFormat int
+
+const (
+	bodyFormatUnknown bodyFormat = iota
+	bodyFormatJSON
+	bodyFormatXML
+	bodyFormatForm
+)
+
+func bodyFormatOf(body, contentType string) bodyFormat {
+	switch {
+	case strings.Contains(contentType, "json"):
+		return bodyFormatJSON
+	case strings.Contains(contentType, "xml"):
+		return bodyFormatXML
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		return bodyFormatForm
+	}
+
+	switch body := strings.TrimSpace(body); {
+	case strings.HasPrefix(body, "{"):
+		return bodyFormatJSON
+	case strings.HasPrefix(body, "<"):
+		return bodyFormatXML
+	}
+
+	return bodyFormatUnknown
+}
+
+// hasPathSuffix returns whether the path ends with suffix.
+func hasPathSuffix(path, suffix []string) bool {
+	return len(suffix) > 0 && len(path) >= len(suffix) && slices.Equal(path[len(path)-len(suffix):], suffix)
+}
+
+// jsonValuesAtPath returns the string values in a JSON document whose key path ends with path.
+func jsonValuesAtPath(body string, path []string) []string {
+	var doc any
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		return nil
+	}
+
+	var values []string
+	var walk func(v any, keys []string)
+	walk = func(v any, keys []string) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, child := range v {
+				walk(child, append(slices.Clip(keys), k))
+			}
+		case []any:
+			for _, child := range v {
+				walk(child, keys)
+			}
+		case string:
+			if hasPathSuffix(keys, path) {
+				values = append(values, v)
+			}
+		}
+	}
+	walk(doc, nil)
+
+	return values
+}
+
+// xmlValuesAtPath returns the character data of elements in an XML document whose element path ends with path.
+func xmlValuesAtPath(body string, path []string) []string {
+	var values []string
+	var elements []string
+	var text strings.Builder
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return values
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			elements = append(elements, token.Name.Local)
+			text.Reset()
+		case xml.CharData:
+			text.Write(token)
+		case xml.EndElement:
+			if hasPathSuffix(elements, path) && strings.TrimSpace(text.String()) != "" {
+				values = append(values, text.String())
+			}
+			if len(elements) > 0 {
+				elements = elements[:len(elements)-1]
+			}
+			text.Reset()
+		}
+	}
+
+	return values
+}
+
+func identity(v string) string {
+	return v
+}
+
+func jsonEscape(v string) string {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return v
+	}
+
+	// Strip the surrounding quotes and trailing newline.
+	return strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(b.String(), `"`), "\n"), `"`)
+}
+
+func xmlEscape(v string) string {
+	var b bytes.Buffer
+	if err := xml.EscapeText(&b, []byte(v)); err != nil {
+		return v
+	}
+
+	return b.String()
+}
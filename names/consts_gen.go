@@ -220,6 +220,7 @@ const (
 	SSMSAP                       = "ssmsap"
 	SSO                          = "sso"
 	SSOAdmin                     = "ssoadmin"
+	SSOOIDC                      = "ssooidc"
 	STS                          = "sts"
 	SWF                          = "swf"
 	SageMaker                    = "sagemaker"
@@ -475,6 +476,7 @@ const (
 	SSMSAPServiceID                       = "Ssm Sap"
 	SSOServiceID                          = "SSO"
 	SSOAdminServiceID                     = "SSO Admin"
+	SSOOIDCServiceID                      = "SSO OIDC"
 	STSServiceID                          = "STS"
 	SWFServiceID                          = "SWF"
 	SageMakerServiceID                    = "SageMaker"
@@ -3307,6 +3307,9 @@ service "invoicing" {
 
   endpoint_info {
     endpoint_api_call = "ListInvoiceUnits"
+    endpoint_region_overrides = {
+      "aws" = "us-east-1"
+    }
   }
 
   resource_prefix {
@@ -3316,6 +3319,8 @@ service "invoicing" {
   provider_package_correct = "invoicing"
   doc_prefix               = ["invoicing_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "mediaconnect" {
@@ -3436,11 +3441,12 @@ service "mediapackagevod" {
   }
 
   resource_prefix {
+    actual  = "aws_media_packagevod_"
     correct = "aws_mediapackagevod_"
   }
 
   provider_package_correct = "mediapackagevod"
-  doc_prefix               = ["mediapackagevod_"]
+  doc_prefix               = ["media_packagevod_"]
   brand                    = "AWS"
 }
 
@@ -8208,7 +8214,6 @@ service "sso" {
   endpoint_info {
     endpoint_api_call   = "ListAccounts"
     endpoint_api_params = "AccessToken: aws.String(\"mock-access-token\")"
-    endpoint_only       = true
   }
 
   resource_prefix {
@@ -8218,7 +8223,6 @@ service "sso" {
   provider_package_correct = "sso"
   doc_prefix               = ["sso_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "ssoadmin" {
@@ -8291,6 +8295,12 @@ service "ssooidc" {
     human_friendly      = "SSO OIDC"
   }
 
+  endpoint_info {
+    endpoint_api_call   = "RegisterClient"
+    endpoint_api_params = "ClientName: aws.String(\"mock-client\"), ClientType: aws.String(\"public\")"
+    endpoint_only       = true
+  }
+
   resource_prefix {
     correct = "aws_ssooidc_"
   }
@@ -8425,6 +8435,8 @@ service "taxsettings" {
   provider_package_correct = "taxsettings"
   doc_prefix               = ["taxsettings_"]
   brand                    = "Amazon"
+
+  is_global = true
 }
 
 service "textract" {